@@ -6,6 +6,8 @@ import (
 	"io"
 	"strings"
 	"testing"
+
+	"github.com/a-h/templ"
 )
 
 func BenchmarkTempl(b *testing.B) {
@@ -25,6 +27,33 @@ func BenchmarkTempl(b *testing.B) {
 	}
 }
 
+type nopObserver struct{}
+
+func (nopObserver) OnRenderStart(ctx context.Context, info templ.RenderInfo) context.Context {
+	return ctx
+}
+
+func (nopObserver) OnRenderEnd(ctx context.Context, info templ.RenderInfo, bytesWritten int, err error) {
+}
+
+func BenchmarkTemplWithObserver(b *testing.B) {
+	b.ReportAllocs()
+	t := Render(Person{
+		Name:  "Luiz Bonfa",
+		Email: "luiz@example.com",
+	})
+
+	w := new(strings.Builder)
+	for i := 0; i < b.N; i++ {
+		ctx := templ.WithObserver(context.Background(), nopObserver{})
+		err := t.Render(ctx, w)
+		if err != nil {
+			b.Errorf("failed to render: %v", err)
+		}
+		w.Reset()
+	}
+}
+
 var goTemplate = template.Must(template.New("example").Parse(`<div>
 	<h1>{{.Name}}</h1>
 	<div style="font-family: &#39;sans-serif&#39;" id="test" data-contents="something with &#34;quotes&#34; and a &lt;tag&gt;">
@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func Render(p Person) templ.Component {
+func /*line template.templ:3:6*/ Render(p Person) /*line template_templ.go:12:83*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func Render(p Person) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "Render", "testhtml", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -26,16 +31,21 @@ func Render(p Person) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_2 string = p.Name
+		var var_2 string = /*line template.templ:5:8*/ p.Name /*line template_templ.go:34:89*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_2))
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</h1><div style=\"font-family: &#39;sans-serif&#39;\" id=\"test\" data-contents=\"")
+		_, err = templBuffer.WriteString("</h1><div")
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(`something with "quotes" and a <tag>`))
+		templ.AddStyleAttributeHash(ctx, "font-family: 'sans-serif'")
+		_, err = templBuffer.WriteString(" style=\"font-family: &#39;sans-serif&#39;\" id=\"test\" data-contents=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:6:75*/ `something with "quotes" and a <tag>` /*line template_templ.go:48:155*/))
 		if err != nil {
 			return err
 		}
@@ -52,7 +62,7 @@ func Render(p Person) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_4 templ.SafeURL = templ.URL("mailto: " + p.Email)
+		var var_4 templ.SafeURL = /*line template.templ:7:24*/ templ.URL("mailto: " + p.Email) /*line template_templ.go:65:123*/
 		_, err = templBuffer.WriteString(templ.EscapeString(string(var_4)))
 		if err != nil {
 			return err
@@ -61,7 +71,7 @@ func Render(p Person) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_5 string = p.Email
+		var var_5 string = /*line template.templ:7:60*/ p.Email /*line template_templ.go:74:91*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_5))
 		if err != nil {
 			return err
@@ -70,7 +80,7 @@ func Render(p Person) templ.Component {
 		if err != nil {
 			return err
 		}
-		if true {
+		if /*line template.templ:10:16*/ true /*line template_templ.go:83:73*/ {
 			_, err = templBuffer.WriteString(" noshade")
 			if err != nil {
 				return err
@@ -80,7 +90,7 @@ func Render(p Person) templ.Component {
 		if err != nil {
 			return err
 		}
-		if true {
+		if /*line template.templ:11:24*/ true /*line template_templ.go:93:73*/ {
 			_, err = templBuffer.WriteString(" optionB")
 			if err != nil {
 				return err
@@ -90,7 +100,7 @@ func Render(p Person) templ.Component {
 		if err != nil {
 			return err
 		}
-		if false {
+		if /*line template.templ:11:58*/ false /*line template_templ.go:103:75*/ {
 			_, err = templBuffer.WriteString(" optionD")
 			if err != nil {
 				return err
@@ -100,6 +110,9 @@ func Render(p Person) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
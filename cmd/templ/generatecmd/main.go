@@ -39,6 +39,8 @@ type Arguments struct {
 	Proxy                           string
 	WorkerCount                     int
 	GenerateSourceMapVisualisations bool
+	// StripComments removes HTML comments from the output of the generated templates.
+	StripComments bool
 	// PPROFPort is the port to run the pprof server on.
 	PPROFPort int
 }
@@ -81,7 +83,7 @@ func runCmd(ctx context.Context, args Arguments) (err error) {
 		return fmt.Errorf("cannot watch a single file, remove the -f or -watch flag")
 	}
 	if args.FileName != "" {
-		return processSingleFile(ctx, args.FileName, args.GenerateSourceMapVisualisations)
+		return processSingleFile(ctx, args.FileName, args.GenerateSourceMapVisualisations, args.StripComments)
 	}
 	var target *url.URL
 	if args.Proxy != "" {
@@ -116,7 +118,7 @@ func runCmd(ctx context.Context, args Arguments) (err error) {
 	var firstRunComplete bool
 	fileNameToLastModTime := make(map[string]time.Time)
 	for !firstRunComplete || args.Watch {
-		changesFound, errs := processChanges(ctx, fileNameToLastModTime, args.Path, args.GenerateSourceMapVisualisations, args.WorkerCount)
+		changesFound, errs := processChanges(ctx, fileNameToLastModTime, args.Path, args.GenerateSourceMapVisualisations, args.StripComments, args.WorkerCount)
 		if len(errs) > 0 {
 			if errors.Is(errs[0], context.Canceled) {
 				return errs[0]
@@ -180,7 +182,7 @@ func shouldSkipDir(dir string) bool {
 	return false
 }
 
-func processChanges(ctx context.Context, fileNameToLastModTime map[string]time.Time, path string, generateSourceMapVisualisations bool, maxWorkerCount int) (changesFound int, errs []error) {
+func processChanges(ctx context.Context, fileNameToLastModTime map[string]time.Time, path string, generateSourceMapVisualisations, stripComments bool, maxWorkerCount int) (changesFound int, errs []error) {
 	sem := make(chan struct{}, maxWorkerCount)
 	var wg sync.WaitGroup
 
@@ -212,7 +214,7 @@ func processChanges(ctx context.Context, fileNameToLastModTime map[string]time.T
 				wg.Add(1)
 				go func() {
 					defer wg.Done()
-					if err := processSingleFile(ctx, path, generateSourceMapVisualisations); err != nil {
+					if err := processSingleFile(ctx, path, generateSourceMapVisualisations, stripComments); err != nil {
 						errs = append(errs, err)
 					}
 					<-sem
@@ -246,9 +248,9 @@ func openURL(url string) error {
 	return browser.OpenURL(url)
 }
 
-func processSingleFile(ctx context.Context, fileName string, generateSourceMapVisualisations bool) error {
+func processSingleFile(ctx context.Context, fileName string, generateSourceMapVisualisations, stripComments bool) error {
 	start := time.Now()
-	err := compile(ctx, fileName, generateSourceMapVisualisations)
+	err := compile(ctx, fileName, generateSourceMapVisualisations, stripComments)
 	if err != nil {
 		return err
 	}
@@ -256,7 +258,7 @@ func processSingleFile(ctx context.Context, fileName string, generateSourceMapVi
 	return err
 }
 
-func compile(ctx context.Context, fileName string, generateSourceMapVisualisations bool) (err error) {
+func compile(ctx context.Context, fileName string, generateSourceMapVisualisations, stripComments bool) (err error) {
 	if err = ctx.Err(); err != nil {
 		return
 	}
@@ -267,8 +269,14 @@ func compile(ctx context.Context, fileName string, generateSourceMapVisualisatio
 	}
 	targetFileName := strings.TrimSuffix(fileName, ".templ") + "_templ.go"
 
+	opts := []generator.GenerateOpt{
+		generator.WithFileName(fileName),
+	}
+	if stripComments {
+		opts = append(opts, generator.WithoutComments())
+	}
 	var b bytes.Buffer
-	sourceMap, err := generator.Generate(t, &b)
+	sourceMap, err := generator.Generate(t, &b, opts...)
 	if err != nil {
 		return fmt.Errorf("%s generation error: %w", fileName, err)
 	}
@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func list(uris []string) templ.Component {
+func /*line list.templ:3:6*/ list(uris []string) /*line list_templ.go:12:78*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func list(uris []string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "list", "httpdebug", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -35,12 +40,12 @@ func list(uris []string) templ.Component {
 		if err != nil {
 			return err
 		}
-		for _, uri := range uris {
+		for /*line list.templ:12:6*/ _, uri := range uris /*line list_templ.go:43:81*/ {
 			_, err = templBuffer.WriteString("<tr><td>")
 			if err != nil {
 				return err
 			}
-			var var_3 string = uri
+			var var_3 string = /*line list.templ:14:10*/ uri /*line list_templ.go:48:81*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_3))
 			if err != nil {
 				return err
@@ -49,7 +54,7 @@ func list(uris []string) templ.Component {
 			if err != nil {
 				return err
 			}
-			var var_4 templ.SafeURL = getMapURL(uri)
+			var var_4 templ.SafeURL = /*line list.templ:15:18*/ getMapURL(uri) /*line list_templ.go:57:99*/
 			_, err = templBuffer.WriteString(templ.EscapeString(string(var_4)))
 			if err != nil {
 				return err
@@ -67,7 +72,7 @@ func list(uris []string) templ.Component {
 			if err != nil {
 				return err
 			}
-			var var_6 templ.SafeURL = getSourceMapURL(uri)
+			var var_6 templ.SafeURL = /*line list.templ:16:18*/ getSourceMapURL(uri) /*line list_templ.go:75:106*/
 			_, err = templBuffer.WriteString(templ.EscapeString(string(var_6)))
 			if err != nil {
 				return err
@@ -85,7 +90,7 @@ func list(uris []string) templ.Component {
 			if err != nil {
 				return err
 			}
-			var var_8 templ.SafeURL = getTemplURL(uri)
+			var var_8 templ.SafeURL = /*line list.templ:17:18*/ getTemplURL(uri) /*line list_templ.go:93:102*/
 			_, err = templBuffer.WriteString(templ.EscapeString(string(var_8)))
 			if err != nil {
 				return err
@@ -103,7 +108,7 @@ func list(uris []string) templ.Component {
 			if err != nil {
 				return err
 			}
-			var var_10 templ.SafeURL = getGoURL(uri)
+			var var_10 templ.SafeURL = /*line list.templ:18:18*/ getGoURL(uri) /*line list_templ.go:111:101*/
 			_, err = templBuffer.WriteString(templ.EscapeString(string(var_10)))
 			if err != nil {
 				return err
@@ -126,6 +131,9 @@ func list(uris []string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
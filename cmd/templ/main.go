@@ -77,6 +77,7 @@ func generateCmd(args []string) {
 	fileNameFlag := cmd.String("f", "", "Optionally generates code for a single file, e.g. -f header.templ")
 	pathFlag := cmd.String("path", ".", "Generates code for all files in path.")
 	sourceMapVisualisations := cmd.Bool("sourceMapVisualisations", false, "Set to true to generate HTML files to visualise the templ code and its corresponding Go code.")
+	stripCommentsFlag := cmd.Bool("stripComments", false, "Set to true to remove HTML comments from the output of the generated templates.")
 	watchFlag := cmd.Bool("watch", false, "Set to true to watch the path for changes and regenerate code.")
 	cmdFlag := cmd.String("cmd", "", "Set the command to run after generating code.")
 	proxyFlag := cmd.String("proxy", "", "Set the URL to proxy after generating code and executing the command.")
@@ -98,6 +99,7 @@ func generateCmd(args []string) {
 		ProxyPort:                       *proxyPortFlag,
 		WorkerCount:                     *workerCountFlag,
 		GenerateSourceMapVisualisations: *sourceMapVisualisations,
+		StripComments:                   *stripCommentsFlag,
 		PPROFPort:                       *pprofPortFlag,
 	})
 	if err != nil {
@@ -10,7 +10,7 @@ import "io"
 import "bytes"
 import "strings"
 
-func row() templ.CSSClass {
+func /*line sourcemapvisualisation.templ:3:4*/ row /*line sourcemapvisualisation_templ.go:13:98*/ () templ.CSSClass {
 	var templCSSBuilder strings.Builder
 	templCSSBuilder.WriteString(`display:flex;`)
 	templCSSID := templ.CSSID(`row`, templCSSBuilder.String())
@@ -20,7 +20,7 @@ func row() templ.CSSClass {
 	}
 }
 
-func column() templ.CSSClass {
+func /*line sourcemapvisualisation.templ:7:4*/ column /*line sourcemapvisualisation_templ.go:23:102*/ () templ.CSSClass {
 	var templCSSBuilder strings.Builder
 	templCSSBuilder.WriteString(`flex:50%;`)
 	templCSSBuilder.WriteString(`overflow-y:scroll;`)
@@ -32,7 +32,7 @@ func column() templ.CSSClass {
 	}
 }
 
-func code() templ.CSSClass {
+func /*line sourcemapvisualisation.templ:13:4*/ code /*line sourcemapvisualisation_templ.go:35:101*/ () templ.CSSClass {
 	var templCSSBuilder strings.Builder
 	templCSSBuilder.WriteString(`font-family:monospace;`)
 	templCSSID := templ.CSSID(`code`, templCSSBuilder.String())
@@ -42,7 +42,7 @@ func code() templ.CSSClass {
 	}
 }
 
-func combine(templFileName string, left, right templ.Component) templ.Component {
+func /*line sourcemapvisualisation.templ:17:6*/ combine(templFileName string, left, right templ.Component) /*line sourcemapvisualisation_templ.go:45:155*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -50,6 +50,11 @@ func combine(templFileName string, left, right templ.Component) templ.Component
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "combine", "visualize", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -59,7 +64,7 @@ func combine(templFileName string, left, right templ.Component) templ.Component
 		if err != nil {
 			return err
 		}
-		var var_2 string = templFileName
+		var var_2 string = /*line sourcemapvisualisation.templ:20:12*/ templFileName /*line sourcemapvisualisation_templ.go:67:127*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_2))
 		if err != nil {
 			return err
@@ -85,7 +90,7 @@ func combine(templFileName string, left, right templ.Component) templ.Component
 		if err != nil {
 			return err
 		}
-		var var_5 string = templFileName
+		var var_5 string = /*line sourcemapvisualisation.templ:27:9*/ templFileName /*line sourcemapvisualisation_templ.go:93:126*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_5))
 		if err != nil {
 			return err
@@ -94,7 +99,7 @@ func combine(templFileName string, left, right templ.Component) templ.Component
 		if err != nil {
 			return err
 		}
-		var var_6 = []any{templ.Classes(row())}
+		var var_6 = []any{ /*line sourcemapvisualisation.templ:28:16*/ templ.Classes(row()) /*line sourcemapvisualisation_templ.go:102:134*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_6...)
 		if err != nil {
 			return err
@@ -103,7 +108,7 @@ func combine(templFileName string, left, right templ.Component) templ.Component
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_6).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line sourcemapvisualisation.templ:1*/ templ.CSSClasses(var_6).String() /*line sourcemapvisualisation_templ.go:111:176*/))
 		if err != nil {
 			return err
 		}
@@ -111,7 +116,7 @@ func combine(templFileName string, left, right templ.Component) templ.Component
 		if err != nil {
 			return err
 		}
-		var var_7 = []any{templ.Classes(column(), code())}
+		var var_7 = []any{ /*line sourcemapvisualisation.templ:29:17*/ templ.Classes(column(), code()) /*line sourcemapvisualisation_templ.go:119:145*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_7...)
 		if err != nil {
 			return err
@@ -120,7 +125,7 @@ func combine(templFileName string, left, right templ.Component) templ.Component
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_7).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line sourcemapvisualisation.templ:1*/ templ.CSSClasses(var_7).String() /*line sourcemapvisualisation_templ.go:128:176*/))
 		if err != nil {
 			return err
 		}
@@ -128,15 +133,15 @@ func combine(templFileName string, left, right templ.Component) templ.Component
 		if err != nil {
 			return err
 		}
-		err = left.Render(ctx, templBuffer)
+		err = /*line sourcemapvisualisation.templ:30:8*/ left. /*line sourcemapvisualisation_templ.go:136:105*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "combine", FileName: "sourcemapvisualisation.templ", Line: 30, Col: 9}
 		}
 		_, err = templBuffer.WriteString("</div>")
 		if err != nil {
 			return err
 		}
-		var var_8 = []any{templ.Classes(column(), code())}
+		var var_8 = []any{ /*line sourcemapvisualisation.templ:32:17*/ templ.Classes(column(), code()) /*line sourcemapvisualisation_templ.go:144:145*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_8...)
 		if err != nil {
 			return err
@@ -145,7 +150,7 @@ func combine(templFileName string, left, right templ.Component) templ.Component
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_8).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line sourcemapvisualisation.templ:1*/ templ.CSSClasses(var_8).String() /*line sourcemapvisualisation_templ.go:153:176*/))
 		if err != nil {
 			return err
 		}
@@ -153,14 +158,17 @@ func combine(templFileName string, left, right templ.Component) templ.Component
 		if err != nil {
 			return err
 		}
-		err = right.Render(ctx, templBuffer)
+		err = /*line sourcemapvisualisation.templ:33:8*/ right. /*line sourcemapvisualisation_templ.go:161:106*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "combine", FileName: "sourcemapvisualisation.templ", Line: 33, Col: 9}
 		}
 		_, err = templBuffer.WriteString("</div></div></body></html>")
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -168,7 +176,7 @@ func combine(templFileName string, left, right templ.Component) templ.Component
 	})
 }
 
-func highlight(sourceId, targetId string) templ.ComponentScript {
+func /*line sourcemapvisualisation.templ:40:7*/ highlight /*line sourcemapvisualisation_templ.go:179:107*/ ( /*line sourcemapvisualisation.templ:40:17*/ sourceId, targetId string /*line sourcemapvisualisation_templ.go:179:226*/) templ.ComponentScript {
 	return templ.ComponentScript{
 		Name: `__templ_highlight_ae80`,
 		Function: `function __templ_highlight_ae80(sourceId, targetId){let items = document.getElementsByClassName(sourceId);
@@ -183,7 +191,7 @@ func highlight(sourceId, targetId string) templ.ComponentScript {
 	}
 }
 
-func removeHighlight(sourceId, targetId string) templ.ComponentScript {
+func /*line sourcemapvisualisation.templ:51:7*/ removeHighlight /*line sourcemapvisualisation_templ.go:194:113*/ ( /*line sourcemapvisualisation.templ:51:23*/ sourceId, targetId string /*line sourcemapvisualisation_templ.go:194:232*/) templ.ComponentScript {
 	return templ.ComponentScript{
 		Name: `__templ_removeHighlight_58f2`,
 		Function: `function __templ_removeHighlight_58f2(sourceId, targetId){let items = document.getElementsByClassName(sourceId);
@@ -198,7 +206,7 @@ func removeHighlight(sourceId, targetId string) templ.ComponentScript {
 	}
 }
 
-func mappedCharacter(s string, sourceID, targetID string) templ.Component {
+func /*line sourcemapvisualisation.templ:62:6*/ mappedCharacter(s string, sourceID, targetID string) /*line sourcemapvisualisation_templ.go:209:150*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -206,12 +214,17 @@ func mappedCharacter(s string, sourceID, targetID string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "mappedCharacter", "visualize", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_9 := templ.GetChildren(ctx)
 		if var_9 == nil {
 			var_9 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		var var_10 = []any{templ.Classes(templ.Class("mapped"), templ.Class(sourceID), templ.Class(targetID))}
+		var var_10 = []any{ /*line sourcemapvisualisation.templ:63:15*/ templ.Classes(templ.Class("mapped"), templ.Class(sourceID), templ.Class(targetID)) /*line sourcemapvisualisation_templ.go:227:197*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_10...)
 		if err != nil {
 			return err
@@ -224,7 +237,7 @@ func mappedCharacter(s string, sourceID, targetID string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_10).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line sourcemapvisualisation.templ:1*/ templ.CSSClasses(var_10).String() /*line sourcemapvisualisation_templ.go:240:177*/))
 		if err != nil {
 			return err
 		}
@@ -232,8 +245,8 @@ func mappedCharacter(s string, sourceID, targetID string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_11 templ.ComponentScript = highlight(sourceID, targetID)
-		_, err = templBuffer.WriteString(var_11.Call)
+		var var_11 templ.ComponentScript = /*line sourcemapvisualisation.templ:63:114*/ highlight(sourceID, targetID) /*line sourcemapvisualisation_templ.go:248:161*/
+		err = templ.RenderScriptCall(ctx, templBuffer, var_11)
 		if err != nil {
 			return err
 		}
@@ -241,8 +254,8 @@ func mappedCharacter(s string, sourceID, targetID string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_12 templ.ComponentScript = removeHighlight(sourceID, targetID)
-		_, err = templBuffer.WriteString(var_12.Call)
+		var var_12 templ.ComponentScript = /*line sourcemapvisualisation.templ:63:159*/ removeHighlight(sourceID, targetID) /*line sourcemapvisualisation_templ.go:257:167*/
+		err = templ.RenderScriptCall(ctx, templBuffer, var_12)
 		if err != nil {
 			return err
 		}
@@ -250,7 +263,7 @@ func mappedCharacter(s string, sourceID, targetID string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_13 string = s
+		var var_13 string = /*line sourcemapvisualisation.templ:63:199*/ s /*line sourcemapvisualisation_templ.go:266:118*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_13))
 		if err != nil {
 			return err
@@ -259,6 +272,9 @@ func mappedCharacter(s string, sourceID, targetID string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
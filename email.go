@@ -0,0 +1,158 @@
+package templ
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// EmailOptions are the options used by RenderEmail.
+type EmailOptions struct {
+	// Text receives a plain text version of the email, if it's set.
+	Text io.Writer
+}
+
+// WithEmailText sets the writer that RenderEmail writes a plain text version of the email to,
+// for use as the text/plain alternative of a multipart email.
+func WithEmailText(w io.Writer) func(*EmailOptions) {
+	return func(o *EmailOptions) {
+		o.Text = w
+	}
+}
+
+// RenderEmail renders the component as an HTML email. Since many email clients ignore <style>
+// elements, the CSS classes rendered by the component aren't written to <style> elements.
+// Instead, the properties of each class are added to the style attribute of the elements that
+// use it.
+func RenderEmail(ctx context.Context, w io.Writer, c Component, options ...func(*EmailOptions)) (err error) {
+	var opts EmailOptions
+	for _, o := range options {
+		o(&opts)
+	}
+	ctx, v := getContext(ctx)
+	v.emailStyles = map[string]string{}
+	defer func() {
+		v.emailStyles = nil
+	}()
+	b := GetBuffer()
+	defer ReleaseBuffer(b)
+	if err = c.Render(ctx, b); err != nil {
+		return err
+	}
+	output := new(bytes.Buffer)
+	if err = inlineStyles(output, b.Bytes(), v.emailStyles); err != nil {
+		return err
+	}
+	if opts.Text != nil {
+		if err = writeText(opts.Text, output.Bytes()); err != nil {
+			return err
+		}
+	}
+	_, err = output.WriteTo(w)
+	return err
+}
+
+// classProperties returns the properties of a CSS class definition, e.g. "color:red;" for
+// ".className{color:red;}".
+func classProperties(class SafeCSS) string {
+	s := string(class)
+	start, end := strings.Index(s, "{"), strings.LastIndex(s, "}")
+	if start < 0 || end < start {
+		return ""
+	}
+	properties := strings.TrimSpace(s[start+1 : end])
+	if properties != "" && !strings.HasSuffix(properties, ";") {
+		properties += ";"
+	}
+	return properties
+}
+
+// inlineStyles writes the HTML to w, adding the properties of the CSS classes used by each
+// element to its style attribute.
+func inlineStyles(w io.Writer, b []byte, styles map[string]string) (err error) {
+	z := html.NewTokenizer(bytes.NewReader(b))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if err = z.Err(); err != io.EOF {
+				return err
+			}
+			return nil
+		case html.StartTagToken, html.SelfClosingTagToken:
+			raw := z.Raw()
+			t := z.Token()
+			if !inlineElementStyle(&t, styles) {
+				if _, err = w.Write(raw); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err = io.WriteString(w, renderTag(t)); err != nil {
+				return err
+			}
+		default:
+			if _, err = w.Write(z.Raw()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// inlineElementStyle adds the properties of the element's classes to its style attribute, and
+// returns true if the element was changed.
+func inlineElementStyle(t *html.Token, styles map[string]string) bool {
+	classIndex, styleIndex := -1, -1
+	for i, attr := range t.Attr {
+		switch attr.Key {
+		case "class":
+			classIndex = i
+		case "style":
+			styleIndex = i
+		}
+	}
+	if classIndex < 0 {
+		return false
+	}
+	var properties []string
+	for _, className := range strings.Fields(t.Attr[classIndex].Val) {
+		if p, ok := styles[className]; ok && p != "" {
+			properties = append(properties, p)
+		}
+	}
+	if len(properties) == 0 {
+		return false
+	}
+	// Properties in the style attribute override those from classes.
+	if styleIndex >= 0 {
+		properties = append(properties, t.Attr[styleIndex].Val)
+		t.Attr[styleIndex].Val = strings.Join(properties, "")
+		return true
+	}
+	t.Attr = append(t.Attr, html.Attribute{Key: "style", Val: strings.Join(properties, "")})
+	return true
+}
+
+func renderTag(t html.Token) string {
+	var sb strings.Builder
+	sb.WriteString("<")
+	sb.WriteString(t.Data)
+	for _, attr := range t.Attr {
+		sb.WriteString(" ")
+		sb.WriteString(attr.Key)
+		if attr.Val == "" {
+			continue
+		}
+		sb.WriteString(`="`)
+		sb.WriteString(EscapeString(attr.Val))
+		sb.WriteString(`"`)
+	}
+	if t.Type == html.SelfClosingTagToken {
+		sb.WriteString("/")
+	}
+	sb.WriteString(">")
+	return sb.String()
+}
@@ -0,0 +1,115 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderEmail(t *testing.T) {
+	red := templ.ComponentCSSClass{
+		ID:    "red",
+		Class: templ.SafeCSS(".red{color:red;}"),
+	}
+	bold := templ.ComponentCSSClass{
+		ID:    "bold",
+		Class: templ.SafeCSS(".bold{font-weight:bold;}"),
+	}
+	element := func(tag string, attrs string, classes ...any) templ.Component {
+		return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			if err := templ.RenderCSSItems(ctx, w, classes...); err != nil {
+				return err
+			}
+			_, err := io.WriteString(w, "<"+tag+attrs+">text</"+tag+">")
+			return err
+		})
+	}
+
+	tests := []struct {
+		name     string
+		input    templ.Component
+		expected string
+	}{
+		{
+			name:     "class properties are added to the style attribute, and style elements aren't rendered",
+			input:    element("p", ` class="red"`, red),
+			expected: `<p class="red" style="color:red;">text</p>`,
+		},
+		{
+			name:     "the properties of multiple classes are added in order",
+			input:    element("p", ` class="red bold"`, red, bold),
+			expected: `<p class="red bold" style="color:red;font-weight:bold;">text</p>`,
+		},
+		{
+			name:     "existing style attributes take precedence",
+			input:    element("p", ` class="red" style="color: blue"`, red),
+			expected: `<p class="red" style="color:red;color: blue">text</p>`,
+		},
+		{
+			name:     "classes that aren't rendered by templ are left alone",
+			input:    element("p", ` class="other red"`, red),
+			expected: `<p class="other red" style="color:red;">text</p>`,
+		},
+		{
+			name:     "elements without templ classes are unchanged",
+			input:    element("p", ` id="a" data-x='"quoted"'`),
+			expected: `<p id="a" data-x='"quoted"'>text</p>`,
+		},
+		{
+			name:     "attribute values are escaped",
+			input:    element("a", ` class="red" href="/?a=1&amp;b=&quot;2&quot;" hidden`, red),
+			expected: `<a class="red" href="/?a=1&amp;b=&#34;2&#34;" hidden style="color:red;">text</a>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.RenderEmail(context.Background(), b, tt.input); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("a plain text version can be rendered", func(t *testing.T) {
+		html := new(bytes.Buffer)
+		text := new(bytes.Buffer)
+		email := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			if err := element("h1", ` class="red"`, red).Render(ctx, w); err != nil {
+				return err
+			}
+			_, err := io.WriteString(w, `<p>Hello, <a href="https://example.com">world</a>.</p>`)
+			return err
+		})
+		if err := templ.RenderEmail(context.Background(), html, email, templ.WithEmailText(text)); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		expectedHTML := `<h1 class="red" style="color:red;">text</h1><p>Hello, <a href="https://example.com">world</a>.</p>`
+		if diff := cmp.Diff(expectedHTML, html.String()); diff != "" {
+			t.Error(diff)
+		}
+		expectedText := "text\n\nHello, world (https://example.com).\n"
+		if diff := cmp.Diff(expectedText, text.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("CSS is rendered as normal after the email", func(t *testing.T) {
+		ctx := templ.InitializeContext(context.Background())
+		if err := templ.RenderEmail(ctx, io.Discard, element("p", ` class="red"`, red)); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		b := new(bytes.Buffer)
+		if err := templ.RenderCSSItems(ctx, b, red); err != nil {
+			t.Fatalf("failed to render CSS: %v", err)
+		}
+		if diff := cmp.Diff(`<style type="text/css">.red{color:red;}</style>`, b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
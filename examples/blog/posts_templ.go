@@ -9,10 +9,11 @@ import "context"
 import "io"
 import "bytes"
 
+//line posts.templ:3:1
 import "fmt"
-import "time"
+import "time" /*line posts_templ.go:14:44*/
 
-func headerTemplate(name string) templ.Component {
+func /*line posts.templ:6:6*/ headerTemplate(name string) /*line posts_templ.go:16:88*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -20,6 +21,11 @@ func headerTemplate(name string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "headerTemplate", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -29,7 +35,7 @@ func headerTemplate(name string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_2 string = name
+		var var_2 string = /*line posts.templ:8:8*/ name /*line posts_templ.go:38:81*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_2))
 		if err != nil {
 			return err
@@ -38,6 +44,9 @@ func headerTemplate(name string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -45,7 +54,7 @@ func headerTemplate(name string) templ.Component {
 	})
 }
 
-func footerTemplate() templ.Component {
+func /*line posts.templ:12:6*/ footerTemplate() /*line posts_templ.go:57:78*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -53,6 +62,11 @@ func footerTemplate() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "footerTemplate", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_3 := templ.GetChildren(ctx)
 		if var_3 == nil {
 			var_3 = templ.NopComponent
@@ -67,7 +81,7 @@ func footerTemplate() templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_5 string = fmt.Sprintf("%d", time.Now().Year())
+		var var_5 string = /*line posts.templ:14:16*/ fmt.Sprintf("%d", time.Now().Year()) /*line posts_templ.go:84:116*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_5))
 		if err != nil {
 			return err
@@ -76,6 +90,9 @@ func footerTemplate() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -83,7 +100,7 @@ func footerTemplate() templ.Component {
 	})
 }
 
-func navTemplate() templ.Component {
+func /*line posts.templ:18:6*/ navTemplate() /*line posts_templ.go:103:76*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -91,6 +108,11 @@ func navTemplate() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "navTemplate", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_6 := templ.GetChildren(ctx)
 		if var_6 == nil {
 			var_6 = templ.NopComponent
@@ -118,6 +140,9 @@ func navTemplate() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -125,7 +150,7 @@ func navTemplate() templ.Component {
 	})
 }
 
-func layout(name string) templ.Component {
+func /*line posts.templ:27:6*/ layout(name string) /*line posts_templ.go:153:82*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -133,6 +158,11 @@ func layout(name string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "layout", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_9 := templ.GetChildren(ctx)
 		if var_9 == nil {
 			var_9 = templ.NopComponent
@@ -142,7 +172,7 @@ func layout(name string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_10 string = name
+		var var_10 string = /*line posts.templ:29:17*/ name /*line posts_templ.go:175:85*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_10))
 		if err != nil {
 			return err
@@ -151,13 +181,13 @@ func layout(name string) templ.Component {
 		if err != nil {
 			return err
 		}
-		err = headerTemplate(name).Render(ctx, templBuffer)
+		err = /*line posts.templ:31:4*/ headerTemplate(name). /*line posts_templ.go:184:86*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "layout", FileName: "posts.templ", Line: 31, Col: 5}
 		}
-		err = navTemplate().Render(ctx, templBuffer)
+		err = /*line posts.templ:32:4*/ navTemplate(). /*line posts_templ.go:188:79*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "layout", FileName: "posts.templ", Line: 32, Col: 5}
 		}
 		_, err = templBuffer.WriteString("<main>")
 		if err != nil {
@@ -165,20 +195,23 @@ func layout(name string) templ.Component {
 		}
 		err = var_9.Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "layout", FileName: "posts.templ"}
 		}
 		_, err = templBuffer.WriteString("</main></body>")
 		if err != nil {
 			return err
 		}
-		err = footerTemplate().Render(ctx, templBuffer)
+		err = /*line posts.templ:37:3*/ footerTemplate(). /*line posts_templ.go:204:82*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "layout", FileName: "posts.templ", Line: 37, Col: 4}
 		}
 		_, err = templBuffer.WriteString("</html>")
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -186,7 +219,7 @@ func layout(name string) templ.Component {
 	})
 }
 
-func postsTemplate(posts []Post) templ.Component {
+func /*line posts.templ:41:6*/ postsTemplate(posts []Post) /*line posts_templ.go:222:90*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -194,6 +227,11 @@ func postsTemplate(posts []Post) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "postsTemplate", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_11 := templ.GetChildren(ctx)
 		if var_11 == nil {
 			var_11 = templ.NopComponent
@@ -203,12 +241,12 @@ func postsTemplate(posts []Post) templ.Component {
 		if err != nil {
 			return err
 		}
-		for _, p := range posts {
+		for /*line posts.templ:43:6*/ _, p := range posts /*line posts_templ.go:244:83*/ {
 			_, err = templBuffer.WriteString("<div data-testid=\"postsTemplatePost\"><div data-testid=\"postsTemplatePostName\">")
 			if err != nil {
 				return err
 			}
-			var var_12 string = p.Name
+			var var_12 string = /*line posts.templ:45:47*/ p.Name /*line posts_templ.go:249:88*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_12))
 			if err != nil {
 				return err
@@ -217,7 +255,7 @@ func postsTemplate(posts []Post) templ.Component {
 			if err != nil {
 				return err
 			}
-			var var_13 string = p.Author
+			var var_13 string = /*line posts.templ:46:49*/ p.Author /*line posts_templ.go:258:90*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_13))
 			if err != nil {
 				return err
@@ -231,6 +269,9 @@ func postsTemplate(posts []Post) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -238,7 +279,7 @@ func postsTemplate(posts []Post) templ.Component {
 	})
 }
 
-func home() templ.Component {
+func /*line posts.templ:52:6*/ home() /*line posts_templ.go:282:69*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -246,6 +287,11 @@ func home() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "home", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_14 := templ.GetChildren(ctx)
 		if var_14 == nil {
 			var_14 = templ.NopComponent
@@ -275,9 +321,12 @@ func home() templ.Component {
 			}
 			return err
 		})
-		err = layout("Home").Render(templ.WithChildren(ctx, var_15), templBuffer)
+		err = /*line posts.templ:53:2*/ layout("Home"). /*line posts_templ.go:324:80*/ Render(templ.WithChildren(ctx, var_15), templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "home", FileName: "posts.templ", Line: 53, Col: 3}
+		}
+		if templRender != nil {
+			templRender.Rendered()
 		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
@@ -286,7 +335,7 @@ func home() templ.Component {
 	})
 }
 
-func posts(posts []Post) templ.Component {
+func /*line posts.templ:58:6*/ posts(posts []Post) /*line posts_templ.go:338:82*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -294,6 +343,11 @@ func posts(posts []Post) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "posts", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_17 := templ.GetChildren(ctx)
 		if var_17 == nil {
 			var_17 = templ.NopComponent
@@ -305,7 +359,7 @@ func posts(posts []Post) templ.Component {
 				templBuffer = templ.GetBuffer()
 				defer templ.ReleaseBuffer(templBuffer)
 			}
-			err = postsTemplate(posts).Render(ctx, templBuffer)
+			err = /*line posts.templ:60:3*/ postsTemplate(posts). /*line posts_templ.go:362:87*/ Render(ctx, templBuffer)
 			if err != nil {
 				return err
 			}
@@ -314,9 +368,12 @@ func posts(posts []Post) templ.Component {
 			}
 			return err
 		})
-		err = layout("Posts").Render(templ.WithChildren(ctx, var_18), templBuffer)
+		err = /*line posts.templ:59:2*/ layout("Posts"). /*line posts_templ.go:371:81*/ Render(templ.WithChildren(ctx, var_18), templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "posts", FileName: "posts.templ", Line: 59, Col: 3}
+		}
+		if templRender != nil {
+			templRender.Rendered()
 		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
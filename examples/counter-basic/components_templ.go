@@ -9,9 +9,10 @@ import "context"
 import "io"
 import "bytes"
 
-import "strconv"
+//line components.templ:3:1
+import "strconv" /*line components_templ.go:13:52*/
 
-func counts(global, user int) templ.Component {
+func /*line components.templ:5:6*/ counts(global, user int) /*line components_templ.go:15:95*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -19,6 +20,11 @@ func counts(global, user int) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "counts", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -33,7 +39,7 @@ func counts(global, user int) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_3 string = strconv.Itoa(global)
+		var var_3 string = /*line components.templ:6:16*/ strconv.Itoa(global) /*line components_templ.go:42:109*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_3))
 		if err != nil {
 			return err
@@ -47,7 +53,7 @@ func counts(global, user int) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_5 string = strconv.Itoa(user)
+		var var_5 string = /*line components.templ:7:14*/ strconv.Itoa(user) /*line components_templ.go:56:107*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_5))
 		if err != nil {
 			return err
@@ -56,6 +62,9 @@ func counts(global, user int) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -63,7 +72,7 @@ func counts(global, user int) templ.Component {
 	})
 }
 
-func form() templ.Component {
+func /*line components.templ:10:6*/ form() /*line components_templ.go:75:78*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -71,6 +80,11 @@ func form() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "form", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_6 := templ.GetChildren(ctx)
 		if var_6 == nil {
 			var_6 = templ.NopComponent
@@ -98,6 +112,9 @@ func form() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -105,7 +122,7 @@ func form() templ.Component {
 	})
 }
 
-func page(global, user int) templ.Component {
+func /*line components.templ:17:6*/ page(global, user int) /*line components_templ.go:125:95*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -113,6 +130,11 @@ func page(global, user int) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "page", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_9 := templ.GetChildren(ctx)
 		if var_9 == nil {
 			var_9 = templ.NopComponent
@@ -140,18 +162,21 @@ func page(global, user int) templ.Component {
 		if err != nil {
 			return err
 		}
-		err = counts(global, user).Render(ctx, templBuffer)
+		err = /*line components.templ:40:35*/ counts(global, user). /*line components_templ.go:165:97*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "page", FileName: "components.templ", Line: 40, Col: 36}
 		}
-		err = form().Render(ctx, templBuffer)
+		err = /*line components.templ:40:57*/ form(). /*line components_templ.go:169:83*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "page", FileName: "components.templ", Line: 40, Col: 58}
 		}
 		_, err = templBuffer.WriteString("</div></div></div></section></body></html>")
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -10,9 +10,10 @@ import "io"
 import "bytes"
 import "strings"
 
-import "strconv"
+//line components.templ:3:1
+import "strconv" /*line components_templ.go:14:52*/
 
-func border() templ.CSSClass {
+func /*line components.templ:5:4*/ border /*line components_templ.go:16:77*/ () templ.CSSClass {
 	var templCSSBuilder strings.Builder
 	templCSSBuilder.WriteString(`border:1px solid #eeeeee;`)
 	templCSSBuilder.WriteString(`border-radius:4px;`)
@@ -26,7 +27,7 @@ func border() templ.CSSClass {
 	}
 }
 
-func counts(global, session int) templ.Component {
+func /*line components.templ:13:6*/ counts(global, session int) /*line components_templ.go:30:99*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -34,6 +35,11 @@ func counts(global, session int) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "counts", "components", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -43,7 +49,7 @@ func counts(global, session int) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_2 = []any{"column", "has-text-centered", "is-primary", border}
+		var var_2 = []any{ /*line components.templ:16:16*/ "column", "has-text-centered", "is-primary", border /*line components_templ.go:52:140*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_2...)
 		if err != nil {
 			return err
@@ -52,7 +58,7 @@ func counts(global, session int) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_2).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line components.templ:1*/ templ.CSSClasses(var_2).String() /*line components_templ.go:61:151*/))
 		if err != nil {
 			return err
 		}
@@ -60,7 +66,7 @@ func counts(global, session int) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_3 string = strconv.Itoa(global)
+		var var_3 string = /*line components.templ:17:52*/ strconv.Itoa(global) /*line components_templ.go:69:110*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_3))
 		if err != nil {
 			return err
@@ -87,7 +93,7 @@ func counts(global, session int) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_6 = []any{"column", "has-text-centered", border}
+		var var_6 = []any{ /*line components.templ:21:16*/ "column", "has-text-centered", border /*line components_templ.go:96:126*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_6...)
 		if err != nil {
 			return err
@@ -96,7 +102,7 @@ func counts(global, session int) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_6).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line components.templ:1*/ templ.CSSClasses(var_6).String() /*line components_templ.go:105:152*/))
 		if err != nil {
 			return err
 		}
@@ -104,7 +110,7 @@ func counts(global, session int) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_7 string = strconv.Itoa(session)
+		var var_7 string = /*line components.templ:22:52*/ strconv.Itoa(session) /*line components_templ.go:113:112*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_7))
 		if err != nil {
 			return err
@@ -131,6 +137,9 @@ func counts(global, session int) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -138,7 +147,7 @@ func counts(global, session int) templ.Component {
 	})
 }
 
-func Page(global, session int) templ.Component {
+func /*line components.templ:30:6*/ Page(global, session int) /*line components_templ.go:150:98*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -146,6 +155,11 @@ func Page(global, session int) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "Page", "components", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_10 := templ.GetChildren(ctx)
 		if var_10 == nil {
 			var_10 = templ.NopComponent
@@ -182,14 +196,17 @@ func Page(global, session int) templ.Component {
 		if err != nil {
 			return err
 		}
-		err = counts(global, session).Render(ctx, templBuffer)
+		err = /*line components.templ:54:35*/ counts(global, session). /*line components_templ.go:199:101*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "Page", FileName: "components.templ", Line: 54, Col: 36}
 		}
 		_, err = templBuffer.WriteString("</div></div></div></section></body></html>")
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func graph(data []TimeValue) templ.ComponentScript {
+func /*line components.templ:3:7*/ graph /*line components_templ.go:12:76*/ ( /*line components.templ:3:13*/ data []TimeValue /*line components_templ.go:12:160*/) templ.ComponentScript {
 	return templ.ComponentScript{
 		Name: `__templ_graph_c2ba`,
 		Function: `function __templ_graph_c2ba(data){const chart = LightweightCharts.createChart(document.body, { width: 400, height: 300 });
@@ -19,7 +19,7 @@ func graph(data []TimeValue) templ.ComponentScript {
 	}
 }
 
-func page(data []TimeValue) templ.Component {
+func /*line components.templ:9:6*/ page(data []TimeValue) /*line components_templ.go:22:93*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -27,6 +27,11 @@ func page(data []TimeValue) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "page", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -62,8 +67,8 @@ func page(data []TimeValue) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_4 templ.ComponentScript = graph(data)
-		_, err = templBuffer.WriteString(var_4.Call)
+		var var_4 templ.ComponentScript = /*line components.templ:17:17*/ graph(data) /*line components_templ.go:70:116*/
+		err = templ.RenderScriptCall(ctx, templBuffer, var_4)
 		if err != nil {
 			return err
 		}
@@ -71,6 +76,9 @@ func page(data []TimeValue) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
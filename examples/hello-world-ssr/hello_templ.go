@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func hello(name string) templ.Component {
+func /*line hello.templ:3:6*/ hello(name string) /*line hello_templ.go:12:79*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func hello(name string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "hello", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -31,7 +36,7 @@ func hello(name string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_3 string = name
+		var var_3 string = /*line hello.templ:4:15*/ name /*line hello_templ.go:39:82*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_3))
 		if err != nil {
 			return err
@@ -40,6 +45,9 @@ func hello(name string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -9,10 +9,11 @@ import "context"
 import "io"
 import "bytes"
 
+//line blog.templ:3:1
 import "path"
-import "github.com/gosimple/slug"
+import "github.com/gosimple/slug" /*line blog_templ.go:14:63*/
 
-func headerComponent(title string) templ.Component {
+func /*line blog.templ:6:6*/ headerComponent(title string) /*line blog_templ.go:16:88*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -20,6 +21,11 @@ func headerComponent(title string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "headerComponent", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -29,7 +35,7 @@ func headerComponent(title string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_2 string = title
+		var var_2 string = /*line blog.templ:7:16*/ title /*line blog_templ.go:38:81*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_2))
 		if err != nil {
 			return err
@@ -38,6 +44,9 @@ func headerComponent(title string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -45,7 +54,7 @@ func headerComponent(title string) templ.Component {
 	})
 }
 
-func contentComponent(title string, body templ.Component) templ.Component {
+func /*line blog.templ:10:6*/ contentComponent(title string, body templ.Component) /*line blog_templ.go:57:113*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -53,6 +62,11 @@ func contentComponent(title string, body templ.Component) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "contentComponent", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_3 := templ.GetChildren(ctx)
 		if var_3 == nil {
 			var_3 = templ.NopComponent
@@ -62,7 +76,7 @@ func contentComponent(title string, body templ.Component) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_4 string = title
+		var var_4 string = /*line blog.templ:12:8*/ title /*line blog_templ.go:79:81*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_4))
 		if err != nil {
 			return err
@@ -71,14 +85,17 @@ func contentComponent(title string, body templ.Component) templ.Component {
 		if err != nil {
 			return err
 		}
-		err = body.Render(ctx, templBuffer)
+		err = /*line blog.templ:14:6*/ body. /*line blog_templ.go:88:67*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "contentComponent", FileName: "blog.templ", Line: 14, Col: 7}
 		}
 		_, err = templBuffer.WriteString("</div></body>")
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -86,7 +103,7 @@ func contentComponent(title string, body templ.Component) templ.Component {
 	})
 }
 
-func contentPage(title string, body templ.Component) templ.Component {
+func /*line blog.templ:19:6*/ contentPage(title string, body templ.Component) /*line blog_templ.go:106:109*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -94,6 +111,11 @@ func contentPage(title string, body templ.Component) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "contentPage", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_5 := templ.GetChildren(ctx)
 		if var_5 == nil {
 			var_5 = templ.NopComponent
@@ -103,18 +125,21 @@ func contentPage(title string, body templ.Component) templ.Component {
 		if err != nil {
 			return err
 		}
-		err = headerComponent(title).Render(ctx, templBuffer)
+		err = /*line blog.templ:21:3*/ headerComponent(title). /*line blog_templ.go:128:86*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "contentPage", FileName: "blog.templ", Line: 21, Col: 4}
 		}
-		err = contentComponent(title, body).Render(ctx, templBuffer)
+		err = /*line blog.templ:22:3*/ contentComponent(title, body). /*line blog_templ.go:132:93*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "contentPage", FileName: "blog.templ", Line: 22, Col: 4}
 		}
 		_, err = templBuffer.WriteString("</html>")
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -122,7 +147,7 @@ func contentPage(title string, body templ.Component) templ.Component {
 	})
 }
 
-func indexPage(posts []Post) templ.Component {
+func /*line blog.templ:26:6*/ indexPage(posts []Post) /*line blog_templ.go:150:84*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -130,6 +155,11 @@ func indexPage(posts []Post) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "indexPage", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_6 := templ.GetChildren(ctx)
 		if var_6 == nil {
 			var_6 = templ.NopComponent
@@ -139,9 +169,9 @@ func indexPage(posts []Post) templ.Component {
 		if err != nil {
 			return err
 		}
-		err = headerComponent("My Blog").Render(ctx, templBuffer)
+		err = /*line blog.templ:28:3*/ headerComponent("My Blog"). /*line blog_templ.go:172:90*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "indexPage", FileName: "blog.templ", Line: 28, Col: 4}
 		}
 		_, err = templBuffer.WriteString("<body><h1>")
 		if err != nil {
@@ -156,12 +186,12 @@ func indexPage(posts []Post) templ.Component {
 		if err != nil {
 			return err
 		}
-		for _, post := range posts {
+		for /*line blog.templ:31:7*/ _, post := range posts /*line blog_templ.go:189:84*/ {
 			_, err = templBuffer.WriteString("<div><a href=\"")
 			if err != nil {
 				return err
 			}
-			var var_8 templ.SafeURL = templ.SafeURL(path.Join(post.Date.Format("2006/01/02"), slug.Make(post.Title), "/"))
+			var var_8 templ.SafeURL = /*line blog.templ:32:19*/ templ.SafeURL(path.Join(post.Date.Format("2006/01/02"), slug.Make(post.Title), "/")) /*line blog_templ.go:194:171*/
 			_, err = templBuffer.WriteString(templ.EscapeString(string(var_8)))
 			if err != nil {
 				return err
@@ -170,7 +200,7 @@ func indexPage(posts []Post) templ.Component {
 			if err != nil {
 				return err
 			}
-			var var_9 string = post.Title
+			var var_9 string = /*line blog.templ:32:108*/ post.Title /*line blog_templ.go:203:90*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_9))
 			if err != nil {
 				return err
@@ -184,6 +214,9 @@ func indexPage(posts []Post) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func list(items []string) templ.Component {
+func /*line templsyntax.templ:3:6*/ list(items []string) /*line templsyntax_templ.go:12:93*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func list(items []string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "list", "main", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -26,12 +31,12 @@ func list(items []string) templ.Component {
 		if err != nil {
 			return err
 		}
-		for _, item := range items {
+		for /*line templsyntax.templ:5:6*/ _, item := range items /*line templsyntax_templ.go:34:96*/ {
 			_, err = templBuffer.WriteString("<li>")
 			if err != nil {
 				return err
 			}
-			var var_2 string = item
+			var var_2 string = /*line templsyntax.templ:6:9*/ item /*line templsyntax_templ.go:39:94*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_2))
 			if err != nil {
 				return err
@@ -45,6 +50,9 @@ func list(items []string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -6,19 +6,46 @@ import (
 	"fmt"
 	"html"
 	"io"
+	"path/filepath"
 	"reflect"
 	"runtime/debug"
 	"strings"
 
+	"github.com/a-h/templ"
 	"github.com/a-h/templ/parser/v2"
 )
 
-func Generate(template parser.TemplateFile, w io.Writer) (sm *parser.SourceMap, err error) {
+// GenerateOpt is an option for the Generate function.
+type GenerateOpt func(g *generator)
+
+// WithFileName sets the name of the templ file that is being generated. When set, Go
+// line directives are added around the Go expressions in the template, so that the Go
+// toolchain reports compile errors and panics at their positions in the templ file.
+// The generated Go file is expected to be written next to the templ file, with the
+// _templ.go suffix.
+func WithFileName(fileName string) GenerateOpt {
+	return func(g *generator) {
+		g.fileName = filepath.Base(fileName)
+		g.goFileName = strings.TrimSuffix(g.fileName, ".templ") + "_templ.go"
+	}
+}
+
+// WithoutComments strips HTML comments from the output of the generated templates.
+func WithoutComments() GenerateOpt {
+	return func(g *generator) {
+		g.stripComments = true
+	}
+}
+
+func Generate(template parser.TemplateFile, w io.Writer, opts ...GenerateOpt) (sm *parser.SourceMap, err error) {
 	g := generator{
 		tf:        template,
 		w:         NewRangeWriter(w),
 		sourceMap: parser.NewSourceMap(),
 	}
+	for _, opt := range opts {
+		opt(&g)
+	}
 	err = g.generate()
 	sm = g.sourceMap
 	return
@@ -30,6 +57,20 @@ type generator struct {
 	sourceMap   *parser.SourceMap
 	variableID  int
 	childrenVar string
+	// slotsVar is the name of the variable containing the named slots passed to the template.
+	slotsVar string
+	// templateName is the name of the template being generated, used in render errors.
+	templateName string
+	// childrenDepth is the number of children components that the nodes being generated are
+	// within. Render errors within children are returned unwrapped, since they're wrapped by
+	// the component that renders the children, and by the call to that component.
+	childrenDepth int
+	// fileName is the name of the templ file used in line directives.
+	fileName string
+	// goFileName is the name of the generated Go file used in line directives.
+	goFileName string
+	// stripComments removes HTML comments from the output.
+	stripComments bool
 }
 
 func (g *generator) generate() (err error) {
@@ -170,7 +211,7 @@ func (g *generator) writeCSS(n parser.CSSTemplate) error {
 	if _, err = g.w.Write("func "); err != nil {
 		return err
 	}
-	if r, err = g.w.Write(n.Name.Value); err != nil {
+	if r, err = g.writeExpression(n.Name); err != nil {
 		return err
 	}
 	g.sourceMap.Add(n.Name, r)
@@ -196,7 +237,7 @@ func (g *generator) writeCSS(n parser.CSSTemplate) error {
 				if _, err = g.w.WriteIndent(indentLevel, fmt.Sprintf("templCSSBuilder.WriteString(string(templ.SanitizeCSS(`%s`, ", p.Name)); err != nil {
 					return err
 				}
-				if r, err = g.w.Write(p.Value.Expression.Value); err != nil {
+				if r, err = g.writeExpression(p.Value.Expression); err != nil {
 					return err
 				}
 				g.sourceMap.Add(p.Value.Expression, r)
@@ -239,7 +280,7 @@ func (g *generator) writeCSS(n parser.CSSTemplate) error {
 }
 
 func (g *generator) writeGoExpression(n parser.GoExpression) (err error) {
-	r, err := g.w.Write(n.Expression.Value)
+	r, err := g.writeExpression(n.Expression)
 	if err != nil {
 		return err
 	}
@@ -276,17 +317,64 @@ func (g *generator) writeTemplBuffer(indentLevel int) (err error) {
 	return
 }
 
+// writeObserverStart notifies the templ.Observer on the context, if there is one, that the
+// template has started rendering, and that it has ended when the function returns.
+func (g *generator) writeObserverStart(indentLevel int) (err error) {
+	pkg := strings.TrimSpace(strings.TrimPrefix(g.tf.Package.Expression.Value, "package"))
+	// var templRender *templ.ObservedRender
+	if _, err = g.w.WriteIndent(indentLevel, "var templRender *templ.ObservedRender\n"); err != nil {
+		return err
+	}
+	// if templObserver := templ.GetObserver(ctx); templObserver != nil {
+	if _, err = g.w.WriteIndent(indentLevel, "if templObserver := templ.GetObserver(ctx); templObserver != nil {\n"); err != nil {
+		return err
+	}
+	{
+		indentLevel++
+		// ctx, templRender = templ.StartRender(ctx, templObserver, "name", "pkg", templBuffer)
+		if _, err = g.w.WriteIndent(indentLevel, fmt.Sprintf("ctx, templRender = templ.StartRender(ctx, templObserver, %q, %q, templBuffer)\n", g.templateName, pkg)); err != nil {
+			return err
+		}
+		// defer func() { templRender.End(err) }()
+		if _, err = g.w.WriteIndent(indentLevel, "defer func() { templRender.End(err) }()\n"); err != nil {
+			return err
+		}
+		indentLevel--
+	}
+	if _, err = g.w.WriteIndent(indentLevel, "}\n"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// writeObserverRendered records the number of bytes written by the template, before the
+// buffer is written to the output.
+func (g *generator) writeObserverRendered(indentLevel int) (err error) {
+	if _, err = g.w.WriteIndent(indentLevel, "if templRender != nil {\n"); err != nil {
+		return err
+	}
+	if _, err = g.w.WriteIndent(indentLevel+1, "templRender.Rendered()\n"); err != nil {
+		return err
+	}
+	if _, err = g.w.WriteIndent(indentLevel, "}\n"); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (g *generator) writeTemplate(nodeIdx int, t parser.HTMLTemplate) error {
 	var r parser.Range
 	var err error
 	var indentLevel int
 
+	g.templateName = getTemplateName(t.Expression.Value)
+
 	// func
 	if _, err = g.w.Write("func "); err != nil {
 		return err
 	}
 	// (r *Receiver) Name(params []string)
-	if r, err = g.w.Write(t.Expression.Value); err != nil {
+	if r, err = g.writeExpression(t.Expression); err != nil {
 		return err
 	}
 	g.sourceMap.Add(t.Expression, r)
@@ -308,6 +396,9 @@ func (g *generator) writeTemplate(nodeIdx int, t parser.HTMLTemplate) error {
 		if _, err = g.w.WriteIndent(indentLevel, "ctx = templ.InitializeContext(ctx)\n"); err != nil {
 			return err
 		}
+		if err = g.writeObserverStart(indentLevel); err != nil {
+			return err
+		}
 		g.childrenVar = g.createVariableName()
 		// var_1 := templ.GetChildren(ctx)
 		//  if var_1 == nil {
@@ -329,6 +420,14 @@ func (g *generator) writeTemplate(nodeIdx int, t parser.HTMLTemplate) error {
 		if _, err = g.w.WriteIndent(indentLevel, "}\n"); err != nil {
 			return err
 		}
+		// var_2 := templ.GetSlots(ctx)
+		g.slotsVar = ""
+		if containsSlotExpression(t.Children) {
+			g.slotsVar = g.createVariableName()
+			if _, err = g.w.WriteIndent(indentLevel, fmt.Sprintf("%s := templ.GetSlots(ctx)\n", g.slotsVar)); err != nil {
+				return err
+			}
+		}
 		// ctx = templ.ClearChildren(children)
 		if _, err = g.w.WriteIndent(indentLevel, "ctx = templ.ClearChildren(ctx)\n"); err != nil {
 			return err
@@ -337,6 +436,9 @@ func (g *generator) writeTemplate(nodeIdx int, t parser.HTMLTemplate) error {
 		if err = g.writeNodes(indentLevel, stripWhitespace(t.Children)); err != nil {
 			return err
 		}
+		if err = g.writeObserverRendered(indentLevel); err != nil {
+			return err
+		}
 		// Return the buffer.
 		if _, err = g.w.WriteIndent(indentLevel, "if !templIsBuffer {\n"); err != nil {
 			return err
@@ -462,10 +564,16 @@ func (g *generator) writeNode(indentLevel int, current parser.Node) (err error)
 	switch n := current.(type) {
 	case parser.DocType:
 		err = g.writeDocType(indentLevel, n)
+	case parser.HTMLComment:
+		err = g.writeHTMLComment(indentLevel, n)
 	case parser.Element:
 		err = g.writeElement(indentLevel, n)
 	case parser.ChildrenExpression:
 		err = g.writeChildrenExpression(indentLevel)
+	case parser.SlotExpression:
+		err = g.writeSlotExpression(indentLevel, n)
+	case parser.Slot:
+		err = fmt.Errorf("slot %s: slots must be passed directly to a templ element, e.g. @card() { @slot(%s) { ... } }", n.Name.Value, n.Name.Value)
 	case parser.RawElement:
 		err = g.writeRawElement(indentLevel, n)
 	case parser.ForExpression:
@@ -474,12 +582,18 @@ func (g *generator) writeNode(indentLevel int, current parser.Node) (err error)
 		err = g.writeCallTemplateExpression(indentLevel, n)
 	case parser.TemplElementExpression:
 		err = g.writeTemplElementExpression(indentLevel, n)
+	case parser.Fragment:
+		err = g.writeFragment(indentLevel, n)
+	case parser.Once:
+		err = g.writeOnce(indentLevel, n)
 	case parser.IfExpression:
 		err = g.writeIfExpression(indentLevel, n)
 	case parser.SwitchExpression:
 		err = g.writeSwitchExpression(indentLevel, n)
 	case parser.StringExpression:
 		err = g.writeStringExpression(indentLevel, n.Expression)
+	case parser.GoCode:
+		err = g.writeGoCode(indentLevel, n)
 	case parser.Whitespace:
 		err = g.writeWhitespace(indentLevel, n)
 	case parser.Text:
@@ -497,6 +611,13 @@ func (g *generator) writeDocType(indentLevel int, n parser.DocType) (err error)
 	return nil
 }
 
+func (g *generator) writeHTMLComment(indentLevel int, n parser.HTMLComment) (err error) {
+	if g.stripComments {
+		return nil
+	}
+	return g.writeText(indentLevel, parser.Text{Value: "<!--" + n.Contents + "-->"})
+}
+
 func (g *generator) writeIfExpression(indentLevel int, n parser.IfExpression) (err error) {
 	var r parser.Range
 	// if
@@ -504,7 +625,7 @@ func (g *generator) writeIfExpression(indentLevel int, n parser.IfExpression) (e
 		return err
 	}
 	// x == y {
-	if r, err = g.w.Write(n.Expression.Value); err != nil {
+	if r, err = g.writeExpression(n.Expression); err != nil {
 		return err
 	}
 	g.sourceMap.Add(n.Expression, r)
@@ -525,7 +646,7 @@ func (g *generator) writeIfExpression(indentLevel int, n parser.IfExpression) (e
 			return err
 		}
 		// x == y {
-		if r, err = g.w.Write(elseIf.Expression.Value); err != nil {
+		if r, err = g.writeExpression(elseIf.Expression); err != nil {
 			return err
 		}
 		g.sourceMap.Add(elseIf.Expression, r)
@@ -568,7 +689,7 @@ func (g *generator) writeSwitchExpression(indentLevel int, n parser.SwitchExpres
 		return err
 	}
 	// val
-	if r, err = g.w.Write(n.Expression.Value); err != nil {
+	if r, err = g.writeExpression(n.Expression); err != nil {
 		return err
 	}
 	g.sourceMap.Add(n.Expression, r)
@@ -581,7 +702,10 @@ func (g *generator) writeSwitchExpression(indentLevel int, n parser.SwitchExpres
 		for _, c := range n.Cases {
 			// case x:
 			// default:
-			if r, err = g.w.WriteIndent(indentLevel, c.Expression.Value); err != nil {
+			if _, err = g.w.WriteIndent(indentLevel, ""); err != nil {
+				return err
+			}
+			if r, err = g.writeExpression(c.Expression); err != nil {
 				return err
 			}
 			g.sourceMap.Add(c.Expression, r)
@@ -603,12 +727,101 @@ func (g *generator) writeChildrenExpression(indentLevel int) (err error) {
 	if _, err = g.w.WriteIndent(indentLevel, fmt.Sprintf("err = %s.Render(ctx, templBuffer)\n", g.childrenVar)); err != nil {
 		return err
 	}
-	if err = g.writeErrorHandler(indentLevel); err != nil {
+	// The children expression doesn't have a position in the templ file.
+	if err = g.writeRenderErrorHandler(indentLevel, parser.Position{}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (g *generator) writeGoCode(indentLevel int, n parser.GoCode) (err error) {
+	if _, err = g.w.WriteIndent(indentLevel, ""); err != nil {
+		return err
+	}
+	// total := sum(items)
+	var r parser.Range
+	if r, err = g.writeExpression(n.Expression); err != nil {
+		return err
+	}
+	g.sourceMap.Add(n.Expression, r)
+	if _, err = g.w.Write("\n"); err != nil {
 		return err
 	}
 	return nil
 }
 
+func (g *generator) writeSlotExpression(indentLevel int, n parser.SlotExpression) (err error) {
+	// err = var_2.Get(
+	if _, err = g.w.WriteIndent(indentLevel, fmt.Sprintf("err = %s.Get(", g.slotsVar)); err != nil {
+		return err
+	}
+	// "name", fallback
+	var r parser.Range
+	if r, err = g.writeExpression(n.Expression); err != nil {
+		return err
+	}
+	g.sourceMap.Add(n.Expression, r)
+	// ).Render(ctx, templBuffer)
+	if _, err = g.w.Write(").Render(ctx, templBuffer)\n"); err != nil {
+		return err
+	}
+	if err = g.writeRenderErrorHandler(indentLevel, n.Expression.Range.From); err != nil {
+		return err
+	}
+	return nil
+}
+
+// containsSlotExpression returns true if the nodes, or any of their descendants, render a
+// named slot.
+func containsSlotExpression(nodes []parser.Node) bool {
+	for _, n := range nodes {
+		switch n := n.(type) {
+		case parser.SlotExpression:
+			return true
+		case parser.Element:
+			if containsSlotExpression(n.Children) {
+				return true
+			}
+		case parser.TemplElementExpression:
+			if containsSlotExpression(n.Children) {
+				return true
+			}
+		case parser.Slot:
+			if containsSlotExpression(n.Children) {
+				return true
+			}
+		case parser.Fragment:
+			if containsSlotExpression(n.Children) {
+				return true
+			}
+		case parser.Once:
+			if containsSlotExpression(n.Children) {
+				return true
+			}
+		case parser.ForExpression:
+			if containsSlotExpression(n.Children) || containsSlotExpression(n.Else) {
+				return true
+			}
+		case parser.IfExpression:
+			if containsSlotExpression(n.Then) || containsSlotExpression(n.Else) {
+				return true
+			}
+			for _, elseIf := range n.ElseIfs {
+				if containsSlotExpression(elseIf.Then) {
+					return true
+				}
+			}
+		case parser.SwitchExpression:
+			for _, c := range n.Cases {
+				if containsSlotExpression(c.Children) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func (g *generator) writeTemplElementExpression(indentLevel int, n parser.TemplElementExpression) (err error) {
 	if len(n.Children) == 0 {
 		return g.writeSelfClosingTemplElementExpression(indentLevel, n)
@@ -618,64 +831,164 @@ func (g *generator) writeTemplElementExpression(indentLevel int, n parser.TemplE
 
 func (g *generator) writeBlockTemplElementExpression(indentLevel int, n parser.TemplElementExpression) (err error) {
 	var r parser.Range
-	childrenName := g.createVariableName()
-	if _, err = g.w.WriteIndent(indentLevel, childrenName+" := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {\n"); err != nil {
+	// Named slots are passed separately from the rest of the children.
+	var slots []parser.Slot
+	var children []parser.Node
+	for _, child := range n.Children {
+		if slot, ok := child.(parser.Slot); ok {
+			slots = append(slots, slot)
+			continue
+		}
+		children = append(children, child)
+	}
+	slotNames := make([]string, len(slots))
+	for i, slot := range slots {
+		if slotNames[i], err = g.writeChildrenComponent(indentLevel, slot.Children); err != nil {
+			return err
+		}
+	}
+	var childrenName string
+	if childrenName, err = g.writeChildrenComponent(indentLevel, children); err != nil {
 		return err
 	}
-	indentLevel++
-	if err := g.writeTemplBuffer(indentLevel); err != nil {
+	if _, err = g.w.WriteIndent(indentLevel, `err = `); err != nil {
 		return err
 	}
-	if err = g.writeNodes(indentLevel, stripLeadingAndTrailingWhitespace(n.Children)); err != nil {
+	if r, err = g.writeExpression(n.Expression); err != nil {
 		return err
 	}
-	// Return the buffer.
-	if _, err = g.w.WriteIndent(indentLevel, "if !templIsBuffer {\n"); err != nil {
+	g.sourceMap.Add(n.Expression, r)
+	// .Render(templ.WithSlot(templ.WithChildren(ctx, children),
+	// 	"name", slot), templBuffer)
+	if _, err = g.w.Write(".Render(" + strings.Repeat("templ.WithSlot(", len(slots)) + "templ.WithChildren(ctx, " + childrenName + ")"); err != nil {
 		return err
 	}
-	{
-		indentLevel++
-		// _, err = io.Copy(w, templBuffer)
-		if _, err = g.w.WriteIndent(indentLevel, "_, err = io.Copy(w, templBuffer)\n"); err != nil {
+	for i, slot := range slots {
+		// Each name is written on a new line, so that gofmt doesn't move its line directive
+		// before the preceding comma.
+		if _, err = g.w.Write(",\n"); err != nil {
+			return err
+		}
+		if _, err = g.w.WriteIndent(indentLevel+1, ""); err != nil {
+			return err
+		}
+		if r, err = g.writeExpression(slot.Name); err != nil {
+			return err
+		}
+		g.sourceMap.Add(slot.Name, r)
+		if _, err = g.w.Write(", " + slotNames[i] + ")"); err != nil {
 			return err
 		}
-		indentLevel--
 	}
-	if _, err = g.w.WriteIndent(indentLevel, "}\n"); err != nil {
+	if _, err = g.w.Write(", templBuffer)\n"); err != nil {
 		return err
 	}
-	// return nil
-	if _, err = g.w.WriteIndent(indentLevel, "return err\n"); err != nil {
+	if err = g.writeRenderErrorHandler(indentLevel, n.Expression.Range.From); err != nil {
 		return err
 	}
-	indentLevel--
-	if _, err = g.w.WriteIndent(indentLevel, "})\n"); err != nil {
+	return nil
+}
+
+func (g *generator) writeFragment(indentLevel int, n parser.Fragment) (err error) {
+	var r parser.Range
+	var childrenName string
+	if childrenName, err = g.writeChildrenComponent(indentLevel, n.Children); err != nil {
 		return err
 	}
-	if _, err = g.w.WriteIndent(indentLevel, `err = `); err != nil {
+	// err = templ.Fragment(
+	if _, err = g.w.WriteIndent(indentLevel, `err = templ.Fragment(`); err != nil {
 		return err
 	}
-	if r, err = g.w.Write(n.Expression.Value); err != nil {
+	// "name"
+	if r, err = g.writeExpression(n.Name); err != nil {
 		return err
 	}
-	g.sourceMap.Add(n.Expression, r)
-	// .Render(templ.WithChildren(ctx, children), templBuffer)
-	if _, err = g.w.Write(".Render(templ.WithChildren(ctx, " + childrenName + "), templBuffer)\n"); err != nil {
+	g.sourceMap.Add(n.Name, r)
+	// ).Render(templ.WithChildren(ctx, children), templBuffer)
+	if _, err = g.w.Write(").Render(templ.WithChildren(ctx, " + childrenName + "), templBuffer)\n"); err != nil {
 		return err
 	}
-	if err = g.writeErrorHandler(indentLevel); err != nil {
+	if err = g.writeRenderErrorHandler(indentLevel, n.Name.Range.From); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (g *generator) writeOnce(indentLevel int, n parser.Once) (err error) {
+	var r parser.Range
+	var childrenName string
+	if childrenName, err = g.writeChildrenComponent(indentLevel, n.Children); err != nil {
+		return err
+	}
+	// err = templ.Once(
+	if _, err = g.w.WriteIndent(indentLevel, `err = templ.Once(`); err != nil {
+		return err
+	}
+	// "key"
+	if r, err = g.writeExpression(n.Key); err != nil {
+		return err
+	}
+	g.sourceMap.Add(n.Key, r)
+	// ).Render(templ.WithChildren(ctx, children), templBuffer)
+	if _, err = g.w.Write(").Render(templ.WithChildren(ctx, " + childrenName + "), templBuffer)\n"); err != nil {
+		return err
+	}
+	if err = g.writeRenderErrorHandler(indentLevel, n.Key.Range.From); err != nil {
 		return err
 	}
 	return nil
 }
 
+// writeChildrenComponent writes a variable containing a component that renders the nodes,
+// which can be passed as children to another component, and returns the variable name.
+func (g *generator) writeChildrenComponent(indentLevel int, nodes []parser.Node) (childrenName string, err error) {
+	childrenName = g.createVariableName()
+	if _, err = g.w.WriteIndent(indentLevel, childrenName+" := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {\n"); err != nil {
+		return
+	}
+	indentLevel++
+	if err = g.writeTemplBuffer(indentLevel); err != nil {
+		return
+	}
+	g.childrenDepth++
+	err = g.writeNodes(indentLevel, stripLeadingAndTrailingWhitespace(nodes))
+	g.childrenDepth--
+	if err != nil {
+		return
+	}
+	// Return the buffer.
+	if _, err = g.w.WriteIndent(indentLevel, "if !templIsBuffer {\n"); err != nil {
+		return
+	}
+	{
+		indentLevel++
+		// _, err = io.Copy(w, templBuffer)
+		if _, err = g.w.WriteIndent(indentLevel, "_, err = io.Copy(w, templBuffer)\n"); err != nil {
+			return
+		}
+		indentLevel--
+	}
+	if _, err = g.w.WriteIndent(indentLevel, "}\n"); err != nil {
+		return
+	}
+	// return nil
+	if _, err = g.w.WriteIndent(indentLevel, "return err\n"); err != nil {
+		return
+	}
+	indentLevel--
+	if _, err = g.w.WriteIndent(indentLevel, "})\n"); err != nil {
+		return
+	}
+	return childrenName, nil
+}
+
 func (g *generator) writeSelfClosingTemplElementExpression(indentLevel int, n parser.TemplElementExpression) (err error) {
 	if _, err = g.w.WriteIndent(indentLevel, `err = `); err != nil {
 		return err
 	}
 	// Template expression.
 	var r parser.Range
-	if r, err = g.w.Write(n.Expression.Value); err != nil {
+	if r, err = g.writeExpression(n.Expression); err != nil {
 		return err
 	}
 	g.sourceMap.Add(n.Expression, r)
@@ -683,7 +996,7 @@ func (g *generator) writeSelfClosingTemplElementExpression(indentLevel int, n pa
 	if _, err = g.w.Write(".Render(ctx, templBuffer)\n"); err != nil {
 		return err
 	}
-	if err = g.writeErrorHandler(indentLevel); err != nil {
+	if err = g.writeRenderErrorHandler(indentLevel, n.Expression.Range.From); err != nil {
 		return err
 	}
 	return nil
@@ -695,7 +1008,7 @@ func (g *generator) writeCallTemplateExpression(indentLevel int, n parser.CallTe
 	}
 	// Template expression.
 	var r parser.Range
-	if r, err = g.w.Write(n.Expression.Value); err != nil {
+	if r, err = g.writeExpression(n.Expression); err != nil {
 		return err
 	}
 	g.sourceMap.Add(n.Expression, r)
@@ -703,7 +1016,7 @@ func (g *generator) writeCallTemplateExpression(indentLevel int, n parser.CallTe
 	if _, err = g.w.Write(".Render(ctx, templBuffer)\n"); err != nil {
 		return err
 	}
-	if err = g.writeErrorHandler(indentLevel); err != nil {
+	if err = g.writeRenderErrorHandler(indentLevel, n.Expression.Range.From); err != nil {
 		return err
 	}
 	return nil
@@ -711,12 +1024,21 @@ func (g *generator) writeCallTemplateExpression(indentLevel int, n parser.CallTe
 
 func (g *generator) writeForExpression(indentLevel int, n parser.ForExpression) (err error) {
 	var r parser.Range
+	// The else block is rendered if the loop has no iterations.
+	var iterated string
+	if len(stripWhitespace(n.Else)) > 0 {
+		iterated = g.createVariableName()
+		// var_1 := false
+		if _, err = g.w.WriteIndent(indentLevel, iterated+" := false\n"); err != nil {
+			return err
+		}
+	}
 	// for
 	if _, err = g.w.WriteIndent(indentLevel, `for `); err != nil {
 		return err
 	}
 	// i, v := range p.Stuff
-	if r, err = g.w.Write(n.Expression.Value); err != nil {
+	if r, err = g.writeExpression(n.Expression); err != nil {
 		return err
 	}
 	g.sourceMap.Add(n.Expression, r)
@@ -726,6 +1048,12 @@ func (g *generator) writeForExpression(indentLevel int, n parser.ForExpression)
 	}
 	// Children.
 	indentLevel++
+	if iterated != "" {
+		// var_1 = true
+		if _, err = g.w.WriteIndent(indentLevel, iterated+" = true\n"); err != nil {
+			return err
+		}
+	}
 	if err = g.writeNodes(indentLevel, stripLeadingAndTrailingWhitespace(n.Children)); err != nil {
 		return err
 	}
@@ -734,6 +1062,22 @@ func (g *generator) writeForExpression(indentLevel int, n parser.ForExpression)
 	if _, err = g.w.WriteIndent(indentLevel, `}`+"\n"); err != nil {
 		return err
 	}
+	if iterated == "" {
+		return nil
+	}
+	// if !var_1 {
+	if _, err = g.w.WriteIndent(indentLevel, "if !"+iterated+" {\n"); err != nil {
+		return err
+	}
+	indentLevel++
+	if err = g.writeNodes(indentLevel, stripLeadingAndTrailingWhitespace(n.Else)); err != nil {
+		return err
+	}
+	indentLevel--
+	// }
+	if _, err = g.w.WriteIndent(indentLevel, `}`+"\n"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -755,6 +1099,43 @@ func (g *generator) writeErrorHandler(indentLevel int) (err error) {
 	return err
 }
 
+// writeRenderErrorHandler writes an error handler for a call to a component's Render method.
+// The error is wrapped in a templ.Error containing the name of the template, and the
+// zero-based position of the call in the templ file, if known.
+func (g *generator) writeRenderErrorHandler(indentLevel int, pos parser.Position) (err error) {
+	_, err = g.w.WriteIndent(indentLevel, "if err != nil {\n")
+	if err != nil {
+		return err
+	}
+	indentLevel++
+	if g.childrenDepth > 0 {
+		_, err = g.w.WriteIndent(indentLevel, "return err\n")
+		if err != nil {
+			return err
+		}
+		indentLevel--
+		_, err = g.w.WriteIndent(indentLevel, "}\n")
+		return err
+	}
+	fields := fmt.Sprintf("Err: err, Name: %q", g.templateName)
+	if g.fileName != "" {
+		fields += fmt.Sprintf(", FileName: %q", g.fileName)
+	}
+	if pos != (parser.Position{}) {
+		fields += fmt.Sprintf(", Line: %d, Col: %d", pos.Line+1, pos.Col+1)
+	}
+	_, err = g.w.WriteIndent(indentLevel, "return &templ.Error{"+fields+"}\n")
+	if err != nil {
+		return err
+	}
+	indentLevel--
+	_, err = g.w.WriteIndent(indentLevel, "}\n")
+	if err != nil {
+		return err
+	}
+	return err
+}
+
 func (g *generator) writeElement(indentLevel int, n parser.Element) (err error) {
 	if n.IsVoidElement() {
 		return g.writeVoidElement(indentLevel, n)
@@ -848,7 +1229,7 @@ func (g *generator) writeAttributeCSS(indentLevel int, attr parser.ExpressionAtt
 		return
 	}
 	// p.Name()
-	if r, err = g.w.Write(attr.Expression.Value); err != nil {
+	if r, err = g.writeExpression(attr.Expression); err != nil {
 		return
 	}
 	g.sourceMap.Add(attr.Expression, r)
@@ -910,6 +1291,32 @@ func isScriptAttribute(name string) bool {
 	return false
 }
 
+// isStyleAttribute returns true if the attribute contains inline CSS.
+func isStyleAttribute(name string) bool {
+	return strings.EqualFold(name, "style")
+}
+
+type urlAttributeKind int
+
+const (
+	urlAttributeKindNone urlAttributeKind = iota
+	// The attribute value is a single URL, e.g. <img src>.
+	urlAttributeKindURL
+	// The attribute value is a comma separated list of URLs with descriptors, e.g. <img srcset>.
+	urlAttributeKindURLList
+)
+
+func getURLAttributeKind(elementName, attrName string) urlAttributeKind {
+	isURL, isList := templ.IsURLAttribute(elementName, attrName)
+	switch {
+	case isList:
+		return urlAttributeKindURLList
+	case isURL:
+		return urlAttributeKindURL
+	}
+	return urlAttributeKindNone
+}
+
 func (g *generator) writeElementScript(indentLevel int, n parser.Element) (err error) {
 	var scriptExpressions []string
 	for i := 0; i < len(n.Attributes); i++ {
@@ -944,6 +1351,12 @@ func (g *generator) writeBoolConstantAttribute(indentLevel int, attr parser.Bool
 
 func (g *generator) writeConstantAttribute(indentLevel int, attr parser.ConstantAttribute) (err error) {
 	name := html.EscapeString(attr.Name)
+	if isStyleAttribute(attr.Name) {
+		// templ.AddStyleAttributeHash(ctx, "color: red")
+		if _, err = g.w.WriteIndent(indentLevel, fmt.Sprintf("templ.AddStyleAttributeHash(ctx, %q)\n", attr.Value)); err != nil {
+			return err
+		}
+	}
 	value := html.EscapeString(attr.Value)
 	value = strings.ReplaceAll(value, "\n", "\\n")
 	if _, err = g.w.WriteStringLiteral(indentLevel, fmt.Sprintf(` %s=\"%s\"`, name, value)); err != nil {
@@ -960,7 +1373,7 @@ func (g *generator) writeBoolExpressionAttribute(indentLevel int, attr parser.Bo
 	}
 	// x == y
 	var r parser.Range
-	if r, err = g.w.Write(attr.Expression.Value); err != nil {
+	if r, err = g.writeExpression(attr.Expression); err != nil {
 		return err
 	}
 	g.sourceMap.Add(attr.Expression, r)
@@ -1001,7 +1414,7 @@ func (g *generator) writeExpressionAttribute(indentLevel int, elementName string
 		}
 		// p.Name()
 		var r parser.Range
-		if r, err = g.w.Write(attr.Expression.Value); err != nil {
+		if r, err = g.writeExpression(attr.Expression); err != nil {
 			return err
 		}
 		g.sourceMap.Add(attr.Expression, r)
@@ -1014,6 +1427,47 @@ func (g *generator) writeExpressionAttribute(indentLevel int, elementName string
 		if err = g.writeErrorHandler(indentLevel); err != nil {
 			return err
 		}
+	} else if isStyleAttribute(attr.Name) {
+		// err = templ.RenderStyleAttribute(ctx, templBuffer,
+		if _, err = g.w.WriteIndent(indentLevel, "err = templ.RenderStyleAttribute(ctx, templBuffer, "); err != nil {
+			return err
+		}
+		// p.Style()
+		var r parser.Range
+		if r, err = g.writeExpression(attr.Expression); err != nil {
+			return err
+		}
+		g.sourceMap.Add(attr.Expression, r)
+		// )
+		if _, err = g.w.Write(")\n"); err != nil {
+			return err
+		}
+		if err = g.writeErrorHandler(indentLevel); err != nil {
+			return err
+		}
+	} else if kind := getURLAttributeKind(elementName, attr.Name); kind != urlAttributeKindNone {
+		// The attribute contains a URL, so it must be sanitized, unless it's a templ.SafeURL.
+		sanitizer := "templ.SanitizeURLAttribute"
+		if kind == urlAttributeKindURLList {
+			sanitizer = "templ.SanitizeSrcsetAttribute"
+		}
+		// _, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute(
+		if _, err = g.w.WriteIndent(indentLevel, "_, err = templBuffer.WriteString(templ.EscapeString(string("+sanitizer+"("); err != nil {
+			return err
+		}
+		// p.Name()
+		var r parser.Range
+		if r, err = g.writeExpression(attr.Expression); err != nil {
+			return err
+		}
+		g.sourceMap.Add(attr.Expression, r)
+		// ))))
+		if _, err = g.w.Write("))))\n"); err != nil {
+			return err
+		}
+		if err = g.writeErrorHandler(indentLevel); err != nil {
+			return err
+		}
 	} else {
 		if isScriptAttribute(attr.Name) {
 			// It's a JavaScript handler, and requires special handling, because we expect a JavaScript expression.
@@ -1024,14 +1478,15 @@ func (g *generator) writeExpressionAttribute(indentLevel int, elementName string
 			}
 			// p.Name()
 			var r parser.Range
-			if r, err = g.w.Write(attr.Expression.Value); err != nil {
+			if r, err = g.writeExpression(attr.Expression); err != nil {
 				return err
 			}
 			g.sourceMap.Add(attr.Expression, r)
 			if _, err = g.w.Write("\n"); err != nil {
 				return err
 			}
-			if _, err = g.w.WriteIndent(indentLevel, "_, err = templBuffer.WriteString("+vn+".Call)\n"); err != nil {
+			// err = templ.RenderScriptCall(ctx, templBuffer, vn)
+			if _, err = g.w.WriteIndent(indentLevel, "err = templ.RenderScriptCall(ctx, templBuffer, "+vn+")\n"); err != nil {
 				return err
 			}
 			if err = g.writeErrorHandler(indentLevel); err != nil {
@@ -1044,7 +1499,7 @@ func (g *generator) writeExpressionAttribute(indentLevel int, elementName string
 			}
 			// p.Name()
 			var r parser.Range
-			if r, err = g.w.Write(attr.Expression.Value); err != nil {
+			if r, err = g.writeExpression(attr.Expression); err != nil {
 				return err
 			}
 			g.sourceMap.Add(attr.Expression, r)
@@ -1071,7 +1526,7 @@ func (g *generator) writeConditionalAttribute(indentLevel int, elementName strin
 	}
 	// x == y
 	var r parser.Range
-	if r, err = g.w.Write(attr.Expression.Value); err != nil {
+	if r, err = g.writeExpression(attr.Expression); err != nil {
 		return err
 	}
 	g.sourceMap.Add(attr.Expression, r)
@@ -1106,6 +1561,27 @@ func (g *generator) writeConditionalAttribute(indentLevel int, elementName strin
 	return nil
 }
 
+func (g *generator) writeSpreadAttributes(indentLevel int, attr parser.SpreadAttributes) (err error) {
+	// err = templ.RenderAttributes(ctx, templBuffer,
+	if _, err = g.w.WriteIndent(indentLevel, "err = templ.RenderAttributes(ctx, templBuffer, "); err != nil {
+		return err
+	}
+	// attrs
+	var r parser.Range
+	if r, err = g.writeExpression(attr.Expression); err != nil {
+		return err
+	}
+	g.sourceMap.Add(attr.Expression, r)
+	// )
+	if _, err = g.w.Write(")\n"); err != nil {
+		return err
+	}
+	if err = g.writeErrorHandler(indentLevel); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (g *generator) writeElementAttributes(indentLevel int, name string, attrs []parser.Attribute) (err error) {
 	for i := 0; i < len(attrs); i++ {
 		switch attr := attrs[i].(type) {
@@ -1117,11 +1593,16 @@ func (g *generator) writeElementAttributes(indentLevel int, name string, attrs [
 			err = g.writeBoolExpressionAttribute(indentLevel, attr)
 		case parser.ExpressionAttribute:
 			err = g.writeExpressionAttribute(indentLevel, name, attr)
+		case parser.SpreadAttributes:
+			err = g.writeSpreadAttributes(indentLevel, attr)
 		case parser.ConditionalAttribute:
 			err = g.writeConditionalAttribute(indentLevel, name, attr)
 		default:
 			err = fmt.Errorf("unknown attribute type %s", reflect.TypeOf(attrs[i]))
 		}
+		if err != nil {
+			return err
+		}
 	}
 	return
 }
@@ -1156,6 +1637,78 @@ func (g *generator) writeRawElement(indentLevel int, n parser.RawElement) (err e
 	return err
 }
 
+// writeExpression writes a Go expression from the template, returning the range of the
+// expression in the output. If a file name has been set, the expression is preceded by a
+// line directive containing its position in the templ file, and followed by a line directive
+// that restores the position in the generated Go file.
+func (g *generator) writeExpression(e parser.Expression) (r parser.Range, err error) {
+	if g.fileName == "" {
+		return g.w.Write(e.Value)
+	}
+	if _, err = g.w.Write(templLineDirective(g.fileName, e.Range.From, g.w.Current.Col == 0)); err != nil {
+		return r, err
+	}
+	if r, err = g.w.Write(e.Value); err != nil {
+		return r, err
+	}
+	if _, err = g.w.Write(" "); err != nil {
+		return r, err
+	}
+	if _, err = g.w.Write(goLineDirective(g.goFileName, g.w.Current)); err != nil {
+		return r, err
+	}
+	return r, nil
+}
+
+// templLineDirective returns a line directive that sets the position of the following
+// expression to its zero-based position in the templ file.
+//
+// gofmt separates /*line*/ comments from the following expression with a space, so the
+// position is set for the space, one column before the expression. Expressions that start
+// in the first column can only be positioned precisely by a //line comment at the start of
+// a line.
+func templLineDirective(fileName string, pos parser.Position, atLineStart bool) string {
+	if pos.Col > 0 {
+		return fmt.Sprintf("/*line %s:%d:%d*/ ", fileName, pos.Line+1, pos.Col)
+	}
+	if atLineStart {
+		return fmt.Sprintf("//line %s:%d:1\n", fileName, pos.Line+1)
+	}
+	return fmt.Sprintf("/*line %s:%d*/ ", fileName, pos.Line+1)
+}
+
+// goLineDirective returns a line directive that restores the position of the character
+// following the directive in the generated Go file, given the zero-based position of the
+// directive.
+func goLineDirective(fileName string, pos parser.Position) string {
+	// The column of the following character depends on the length of the directive.
+	col := int(pos.Col) + 1
+	for {
+		d := fmt.Sprintf("/*line %s:%d:%d*/", fileName, pos.Line+1, col)
+		next := int(pos.Col) + len(d) + 1
+		if next == col {
+			return d
+		}
+		col = next
+	}
+}
+
+// getTemplateName returns the name of a template from its expression, e.g. Name from
+// "(r *Receiver) Name(params []string)".
+func getTemplateName(expression string) string {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "(") {
+		// Skip the receiver.
+		if end := strings.Index(expression, ")"); end > -1 {
+			expression = strings.TrimSpace(expression[end+1:])
+		}
+	}
+	if end := strings.IndexAny(expression, "[("); end > -1 {
+		expression = expression[:end]
+	}
+	return strings.TrimSpace(expression)
+}
+
 func (g *generator) createVariableName() string {
 	g.variableID++
 	return fmt.Sprintf("var_%d", g.variableID)
@@ -1172,10 +1725,13 @@ func (g *generator) writeStringExpression(indentLevel int, e parser.Expression)
 		return err
 	}
 	// p.Name()
-	if r, err = g.w.Write(e.Value + "\n"); err != nil {
+	if r, err = g.writeExpression(e); err != nil {
 		return err
 	}
 	g.sourceMap.Add(e, r)
+	if _, err = g.w.Write("\n"); err != nil {
+		return err
+	}
 	// _, err = templBuffer.WriteString(vn)
 	if _, err = g.w.WriteIndent(indentLevel, "_, err = templBuffer.WriteString(templ.EscapeString("+vn+"))\n"); err != nil {
 		return err
@@ -1236,7 +1792,7 @@ func (g *generator) writeScript(t parser.ScriptTemplate) error {
 	if _, err = g.w.Write("func "); err != nil {
 		return err
 	}
-	if r, err = g.w.Write(t.Name.Value); err != nil {
+	if r, err = g.writeExpression(t.Name); err != nil {
 		return err
 	}
 	g.sourceMap.Add(t.Name, r)
@@ -1245,7 +1801,7 @@ func (g *generator) writeScript(t parser.ScriptTemplate) error {
 		return err
 	}
 	// Write parameters.
-	if r, err = g.w.Write(t.Parameters.Value); err != nil {
+	if r, err = g.writeExpression(t.Parameters); err != nil {
 		return err
 	}
 	g.sourceMap.Add(t.Parameters, r)
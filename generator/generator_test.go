@@ -2,6 +2,11 @@ package generator
 
 import (
 	"bytes"
+	"go/ast"
+	"go/format"
+	goparser "go/parser"
+	"go/token"
+	"strings"
 	"testing"
 
 	"github.com/a-h/templ/parser/v2"
@@ -33,3 +38,220 @@ func TestGeneratorSourceMap(t *testing.T) {
 		t.Errorf("unexpected target:\n%v", diff)
 	}
 }
+
+func TestGeneratorLineDirectives(t *testing.T) {
+	tf, err := parser.ParseString(`package main
+
+templ page(name string) {
+	<div>
+		{ name }
+		if isAdmin(name) {
+			@adminPanel(name)
+		}
+	</div>
+}
+`)
+	if err != nil {
+		t.Fatalf("failed to parse template: %v", err)
+	}
+	w := new(bytes.Buffer)
+	if _, err = Generate(tf, w, WithFileName("/path/to/page.templ")); err != nil {
+		t.Fatalf("failed to generate Go code: %v", err)
+	}
+	// The line directives must survive formatting.
+	src, err := format.Source(w.Bytes())
+	if err != nil {
+		t.Fatalf("failed to format Go code: %v", err)
+	}
+
+	fset := token.NewFileSet()
+	f, err := goparser.ParseFile(fset, "page_templ.go", src, goparser.ParseComments)
+	if err != nil {
+		t.Fatalf("failed to parse Go code: %v", err)
+	}
+	// Collect the position of the first use of each identifier.
+	actual := map[string]token.Position{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			if _, found := actual[id.Name]; !found {
+				actual[id.Name] = fset.Position(id.Pos())
+			}
+		}
+		return true
+	})
+	expected := map[string]token.Position{
+		"page":       {Filename: "page.templ", Line: 3, Column: 7},
+		"name":       {Filename: "page.templ", Line: 3, Column: 12},
+		"isAdmin":    {Filename: "page.templ", Line: 6, Column: 6},
+		"adminPanel": {Filename: "page.templ", Line: 7, Column: 5},
+	}
+	for name, pos := range expected {
+		got := actual[name]
+		got.Offset = 0
+		if diff := cmp.Diff(pos, got); diff != "" {
+			t.Errorf("unexpected position of %q:\n%v", name, diff)
+		}
+	}
+	// Generated code is positioned in the Go file.
+	if got := actual["templBuffer"]; got.Filename != "page_templ.go" {
+		t.Errorf("expected generated code to be positioned in page_templ.go, got %v", got)
+	}
+}
+
+func TestGeneratorWithoutComments(t *testing.T) {
+	tf, err := parser.ParseString(`package main
+
+templ page() {
+	<!-- header -->
+	<div>Content</div>
+}
+`)
+	if err != nil {
+		t.Fatalf("failed to parse template: %v", err)
+	}
+	w := new(bytes.Buffer)
+	if _, err = Generate(tf, w); err != nil {
+		t.Fatalf("failed to generate Go code: %v", err)
+	}
+	if !bytes.Contains(w.Bytes(), []byte("<!-- header -->")) {
+		t.Errorf("expected the comment to be included in the output, got:\n%s", w.String())
+	}
+	w.Reset()
+	if _, err = Generate(tf, w, WithoutComments()); err != nil {
+		t.Fatalf("failed to generate Go code: %v", err)
+	}
+	if bytes.Contains(w.Bytes(), []byte("header")) {
+		t.Errorf("expected the comment to be removed from the output, got:\n%s", w.String())
+	}
+	if !bytes.Contains(w.Bytes(), []byte("Content")) {
+		t.Errorf("expected the element to be included in the output, got:\n%s", w.String())
+	}
+}
+
+func TestGeneratorSlotsMustBePassedToTemplElements(t *testing.T) {
+	tf, err := parser.ParseString(`package main
+
+templ page() {
+	<div>
+		@slot("header") {
+			<h1>Title</h1>
+		}
+	</div>
+}
+`)
+	if err != nil {
+		t.Fatalf("failed to parse template: %v", err)
+	}
+	_, err = Generate(tf, new(bytes.Buffer))
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	expected := `slot "header": slots must be passed directly to a templ element, e.g. @card() { @slot("header") { ... } }`
+	if diff := cmp.Diff(expected, err.Error()); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestGeneratorGoCodeSourceMap(t *testing.T) {
+	tf, err := parser.ParseString(`package main
+
+templ basket(items []int) {
+	{{ total := sum(items) }}
+	<p>{ strconv.Itoa(total) }</p>
+}
+`)
+	if err != nil {
+		t.Fatalf("failed to parse template: %v", err)
+	}
+	w := new(bytes.Buffer)
+	sm, err := Generate(tf, w)
+	if err != nil {
+		t.Fatalf("failed to generate Go code: %v", err)
+	}
+	// The "sum" identifier is at line 3, col 13 of the template.
+	tgt, ok := sm.TargetPositionFromSource(3, 13)
+	if !ok {
+		t.Fatal("expected the Go code to be in the source map")
+	}
+	lines := strings.Split(w.String(), "\n")
+	if got := lines[tgt.Line][tgt.Col:]; !strings.HasPrefix(got, "sum(items)") {
+		t.Errorf("expected the target position to be the start of the sum call, got %q", got)
+	}
+	src, ok := sm.SourcePositionFromTarget(tgt.Line, tgt.Col)
+	if !ok {
+		t.Fatal("expected the generated Go code to map back to the template")
+	}
+	if diff := cmp.Diff(parser.NewPosition(0, 3, 13), parser.NewPosition(0, src.Line, src.Col)); diff != "" {
+		t.Errorf("unexpected source position:\n%v", diff)
+	}
+}
+
+func TestGeneratorGenericsSourceMap(t *testing.T) {
+	tf, err := parser.ParseString(`package main
+
+templ Table[T any](rows []T) {
+	<table></table>
+}
+
+templ (l List[T]) Render() {
+	<ul></ul>
+}
+
+templ page(users []User) {
+	@Table[User](users)
+}
+`)
+	if err != nil {
+		t.Fatalf("failed to parse template: %v", err)
+	}
+	w := new(bytes.Buffer)
+	sm, err := Generate(tf, w)
+	if err != nil {
+		t.Fatalf("failed to generate Go code: %v", err)
+	}
+	lines := strings.Split(w.String(), "\n")
+	tests := []struct {
+		name     string
+		line     uint32
+		col      uint32
+		expected string
+	}{
+		{
+			name:     "type parameter",
+			line:     2,
+			col:      12,
+			expected: "T any](rows []T)",
+		},
+		{
+			name:     "generic receiver",
+			line:     6,
+			col:      9,
+			expected: "List[T]) Render()",
+		},
+		{
+			name:     "type argument",
+			line:     11,
+			col:      8,
+			expected: "User](users)",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tgt, ok := sm.TargetPositionFromSource(tt.line, tt.col)
+			if !ok {
+				t.Fatal("expected the position to be in the source map")
+			}
+			if got := lines[tgt.Line][tgt.Col:]; !strings.HasPrefix(got, tt.expected) {
+				t.Errorf("expected the target position to be the start of %q, got %q", tt.expected, got)
+			}
+			src, ok := sm.SourcePositionFromTarget(tgt.Line, tgt.Col)
+			if !ok {
+				t.Fatal("expected the generated Go code to map back to the template")
+			}
+			if diff := cmp.Diff(parser.NewPosition(0, tt.line, tt.col), parser.NewPosition(0, src.Line, src.Col)); diff != "" {
+				t.Errorf("unexpected source position:\n%v", diff)
+			}
+		})
+	}
+}
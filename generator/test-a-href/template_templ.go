@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func render() templ.Component {
+func /*line template.templ:3:6*/ render() /*line template_templ.go:12:75*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func render() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "render", "testahref", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -35,7 +40,7 @@ func render() templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_3 templ.SafeURL = templ.URL("javascript:alert('should be sanitized')")
+		var var_3 templ.SafeURL = /*line template.templ:5:11*/ templ.URL("javascript:alert('should be sanitized')") /*line template_templ.go:43:144*/
 		_, err = templBuffer.WriteString(templ.EscapeString(string(var_3)))
 		if err != nil {
 			return err
@@ -53,7 +58,7 @@ func render() templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_5 templ.SafeURL = templ.SafeURL("javascript:alert('should not be sanitized')")
+		var var_5 templ.SafeURL = /*line template.templ:6:11*/ templ.SafeURL("javascript:alert('should not be sanitized')") /*line template_templ.go:61:152*/
 		_, err = templBuffer.WriteString(templ.EscapeString(string(var_5)))
 		if err != nil {
 			return err
@@ -71,6 +76,9 @@ func render() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
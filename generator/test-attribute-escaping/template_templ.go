@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func BasicTemplate(url string) templ.Component {
+func /*line template.templ:3:6*/ BasicTemplate(url string) /*line template_templ.go:12:92*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func BasicTemplate(url string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "BasicTemplate", "testhtml", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -26,7 +31,7 @@ func BasicTemplate(url string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_2 templ.SafeURL = templ.URL(url)
+		var var_2 templ.SafeURL = /*line template.templ:5:14*/ templ.URL(url) /*line template_templ.go:34:106*/
 		_, err = templBuffer.WriteString(templ.EscapeString(string(var_2)))
 		if err != nil {
 			return err
@@ -44,6 +49,9 @@ func BasicTemplate(url string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func personTemplate(p person) templ.Component {
+func /*line template.templ:3:6*/ personTemplate(p person) /*line template_templ.go:12:91*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func personTemplate(p person) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "personTemplate", "testcall", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -26,31 +31,39 @@ func personTemplate(p person) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_2 string = p.name
+		var var_2 string = /*line template.templ:5:8*/ p.name /*line template_templ.go:34:89*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_2))
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("</h1><div style=\"font-family: &#39;sans-serif&#39;\" id=\"test\" data-contents=\"")
+		_, err = templBuffer.WriteString("</h1><div")
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(`something with "quotes" and a <tag>`))
+		templ.AddStyleAttributeHash(ctx, "font-family: 'sans-serif'")
+		_, err = templBuffer.WriteString(" style=\"font-family: &#39;sans-serif&#39;\" id=\"test\" data-contents=\"")
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("\">")
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:6:75*/ `something with "quotes" and a <tag>` /*line template_templ.go:48:155*/))
 		if err != nil {
 			return err
 		}
-		err = email(p.email).Render(ctx, templBuffer)
+		_, err = templBuffer.WriteString("\">")
 		if err != nil {
 			return err
 		}
+		err = /*line template.templ:7:6*/ email(p.email). /*line template_templ.go:56:84*/ Render(ctx, templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "personTemplate", FileName: "template.templ", Line: 7, Col: 7}
+		}
 		_, err = templBuffer.WriteString("</div></div>")
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -58,7 +71,7 @@ func personTemplate(p person) templ.Component {
 	})
 }
 
-func email(s string) templ.Component {
+func /*line template.templ:12:6*/ email(s string) /*line template_templ.go:74:83*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -66,6 +79,11 @@ func email(s string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "email", "testcall", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_3 := templ.GetChildren(ctx)
 		if var_3 == nil {
 			var_3 = templ.NopComponent
@@ -84,7 +102,7 @@ func email(s string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_5 templ.SafeURL = templ.URL("mailto: " + s)
+		var var_5 templ.SafeURL = /*line template.templ:13:22*/ templ.URL("mailto: " + s) /*line template_templ.go:105:119*/
 		_, err = templBuffer.WriteString(templ.EscapeString(string(var_5)))
 		if err != nil {
 			return err
@@ -93,7 +111,7 @@ func email(s string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_6 string = s
+		var var_6 string = /*line template.templ:13:52*/ s /*line template_templ.go:114:87*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_6))
 		if err != nil {
 			return err
@@ -102,6 +120,9 @@ func email(s string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
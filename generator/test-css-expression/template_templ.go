@@ -7,11 +7,11 @@ package testcssexpression
 import "github.com/a-h/templ"
 import "strings"
 
-func className() templ.CSSClass {
+func /*line template.templ:3:4*/ className /*line template_templ.go:10:76*/ () templ.CSSClass {
 	var templCSSBuilder strings.Builder
 	templCSSBuilder.WriteString(`background-color:#ffffff;`)
 	templCSSBuilder.WriteString(`max-height:calc(100vh - 170px);`)
-	templCSSBuilder.WriteString(string(templ.SanitizeCSS(`color`, red)))
+	templCSSBuilder.WriteString(string(templ.SanitizeCSS(`color` /*line template.templ:6:10*/, red /*line template_templ.go:14:130*/)))
 	templCSSID := templ.CSSID(`className`, templCSSBuilder.String())
 	return templ.ComponentCSSClass{
 		ID:    templCSSID,
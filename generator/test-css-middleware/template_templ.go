@@ -10,7 +10,7 @@ import "io"
 import "bytes"
 import "strings"
 
-func red() templ.CSSClass {
+func /*line template.templ:3:4*/ red /*line template_templ.go:13:70*/ () templ.CSSClass {
 	var templCSSBuilder strings.Builder
 	templCSSBuilder.WriteString(`color:red;`)
 	templCSSID := templ.CSSID(`red`, templCSSBuilder.String())
@@ -20,7 +20,7 @@ func red() templ.CSSClass {
 	}
 }
 
-func render(s string) templ.Component {
+func /*line template.templ:7:6*/ render(s string) /*line template_templ.go:23:83*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -28,12 +28,17 @@ func render(s string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "render", "testcssmiddleware", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		var var_2 = []any{red}
+		var var_2 = []any{ /*line template.templ:8:14*/ red /*line template_templ.go:41:86*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_2...)
 		if err != nil {
 			return err
@@ -42,7 +47,7 @@ func render(s string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_2).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_2).String() /*line template_templ.go:50:147*/))
 		if err != nil {
 			return err
 		}
@@ -50,7 +55,7 @@ func render(s string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_3 string = s
+		var var_3 string = /*line template.templ:8:22*/ s /*line template_templ.go:58:85*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_3))
 		if err != nil {
 			return err
@@ -59,6 +64,9 @@ func render(s string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -10,7 +10,7 @@ import "io"
 import "bytes"
 import "strings"
 
-func green() templ.CSSClass {
+func /*line template.templ:3:4*/ green /*line template_templ.go:13:72*/ () templ.CSSClass {
 	var templCSSBuilder strings.Builder
 	templCSSBuilder.WriteString(`color:#00ff00;`)
 	templCSSID := templ.CSSID(`green`, templCSSBuilder.String())
@@ -20,10 +20,10 @@ func green() templ.CSSClass {
 	}
 }
 
-func className() templ.CSSClass {
+func /*line template.templ:7:4*/ className /*line template_templ.go:23:76*/ () templ.CSSClass {
 	var templCSSBuilder strings.Builder
 	templCSSBuilder.WriteString(`background-color:#ffffff;`)
-	templCSSBuilder.WriteString(string(templ.SanitizeCSS(`color`, red)))
+	templCSSBuilder.WriteString(string(templ.SanitizeCSS(`color` /*line template.templ:9:10*/, red /*line template_templ.go:26:130*/)))
 	templCSSID := templ.CSSID(`className`, templCSSBuilder.String())
 	return templ.ComponentCSSClass{
 		ID:    templCSSID,
@@ -31,7 +31,7 @@ func className() templ.CSSClass {
 	}
 }
 
-func Button(text string) templ.Component {
+func /*line template.templ:12:6*/ Button(text string) /*line template_templ.go:34:87*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -39,12 +39,17 @@ func Button(text string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "Button", "testcssusage", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		var var_2 = []any{className(), templ.Class("&&&unsafe"), "safe", templ.SafeClass("safe2")}
+		var var_2 = []any{ /*line template.templ:13:17*/ className(), templ.Class("&&&unsafe"), "safe", templ.SafeClass("safe2") /*line template_templ.go:52:156*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_2...)
 		if err != nil {
 			return err
@@ -53,7 +58,7 @@ func Button(text string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_2).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_2).String() /*line template_templ.go:61:147*/))
 		if err != nil {
 			return err
 		}
@@ -61,7 +66,7 @@ func Button(text string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_3 string = text
+		var var_3 string = /*line template.templ:13:107*/ text /*line template_templ.go:69:90*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_3))
 		if err != nil {
 			return err
@@ -70,6 +75,9 @@ func Button(text string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -77,7 +85,7 @@ func Button(text string) templ.Component {
 	})
 }
 
-func LegacySupport() templ.Component {
+func /*line template.templ:16:6*/ LegacySupport() /*line template_templ.go:88:83*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -85,12 +93,17 @@ func LegacySupport() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "LegacySupport", "testcssusage", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_4 := templ.GetChildren(ctx)
 		if var_4 == nil {
 			var_4 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		var var_5 = []any{templ.Classes(templ.Class("test"), "a")}
+		var var_5 = []any{ /*line template.templ:17:14*/ templ.Classes(templ.Class("test"), "a") /*line template_templ.go:106:125*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_5...)
 		if err != nil {
 			return err
@@ -99,7 +112,7 @@ func LegacySupport() templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_5).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_5).String() /*line template_templ.go:115:148*/))
 		if err != nil {
 			return err
 		}
@@ -107,6 +120,9 @@ func LegacySupport() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -114,7 +130,7 @@ func LegacySupport() templ.Component {
 	})
 }
 
-func MapCSSExample() templ.Component {
+func /*line template.templ:20:6*/ MapCSSExample() /*line template_templ.go:133:84*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -122,12 +138,17 @@ func MapCSSExample() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "MapCSSExample", "testcssusage", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_6 := templ.GetChildren(ctx)
 		if var_6 == nil {
 			var_6 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		var var_7 = []any{map[string]bool{"a": true, "b": false, "c": true}}
+		var var_7 = []any{ /*line template.templ:21:14*/ map[string]bool{"a": true, "b": false, "c": true} /*line template_templ.go:151:137*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_7...)
 		if err != nil {
 			return err
@@ -136,7 +157,7 @@ func MapCSSExample() templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_7).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_7).String() /*line template_templ.go:160:148*/))
 		if err != nil {
 			return err
 		}
@@ -144,6 +165,9 @@ func MapCSSExample() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -151,7 +175,7 @@ func MapCSSExample() templ.Component {
 	})
 }
 
-func KVExample() templ.Component {
+func /*line template.templ:24:6*/ KVExample() /*line template_templ.go:178:80*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -159,12 +183,17 @@ func KVExample() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "KVExample", "testcssusage", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_8 := templ.GetChildren(ctx)
 		if var_8 == nil {
 			var_8 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		var var_9 = []any{"a", templ.KV("b", false)}
+		var var_9 = []any{ /*line template.templ:25:14*/ "a", templ.KV("b", false) /*line template_templ.go:196:111*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_9...)
 		if err != nil {
 			return err
@@ -173,7 +202,7 @@ func KVExample() templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_9).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_9).String() /*line template_templ.go:205:148*/))
 		if err != nil {
 			return err
 		}
@@ -181,7 +210,7 @@ func KVExample() templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_10 = []any{"a", "b", "c", templ.KV("c", false)}
+		var var_10 = []any{ /*line template.templ:26:53*/ "a", "b", "c", templ.KV("c", false) /*line template_templ.go:213:122*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_10...)
 		if err != nil {
 			return err
@@ -190,7 +219,7 @@ func KVExample() templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_10).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_10).String() /*line template_templ.go:222:149*/))
 		if err != nil {
 			return err
 		}
@@ -198,6 +227,9 @@ func KVExample() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -205,7 +237,7 @@ func KVExample() templ.Component {
 	})
 }
 
-func PsuedoAttributes() templ.Component {
+func /*line template.templ:29:6*/ PsuedoAttributes() /*line template_templ.go:240:87*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -213,12 +245,17 @@ func PsuedoAttributes() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "PsuedoAttributes", "testcssusage", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_11 := templ.GetChildren(ctx)
 		if var_11 == nil {
 			var_11 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		var var_12 = []any{"bg-violet-500", templ.KV(templ.SafeClass("hover:bg-violet-600"), true)}
+		var var_12 = []any{ /*line template.templ:30:17*/ "bg-violet-500", templ.KV(templ.SafeClass("hover:bg-violet-600"), true) /*line template_templ.go:258:158*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_12...)
 		if err != nil {
 			return err
@@ -227,7 +264,7 @@ func PsuedoAttributes() templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_12).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_12).String() /*line template_templ.go:267:149*/))
 		if err != nil {
 			return err
 		}
@@ -244,6 +281,9 @@ func PsuedoAttributes() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -251,7 +291,7 @@ func PsuedoAttributes() templ.Component {
 	})
 }
 
-func ThreeButtons() templ.Component {
+func /*line template.templ:33:6*/ ThreeButtons() /*line template_templ.go:294:83*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -259,20 +299,25 @@ func ThreeButtons() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "ThreeButtons", "testcssusage", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_14 := templ.GetChildren(ctx)
 		if var_14 == nil {
 			var_14 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		err = Button("A").Render(ctx, templBuffer)
+		err = /*line template.templ:34:4*/ Button("A"). /*line template_templ.go:312:83*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "ThreeButtons", FileName: "template.templ", Line: 34, Col: 5}
 		}
-		err = Button("B").Render(ctx, templBuffer)
+		err = /*line template.templ:35:4*/ Button("B"). /*line template_templ.go:316:83*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "ThreeButtons", FileName: "template.templ", Line: 35, Col: 5}
 		}
-		var var_15 = []any{templ.Classes(green)}
+		var var_15 = []any{ /*line template.templ:36:17*/ templ.Classes(green) /*line template_templ.go:320:107*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_15...)
 		if err != nil {
 			return err
@@ -281,7 +326,7 @@ func ThreeButtons() templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_15).String()))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_15).String() /*line template_templ.go:329:149*/))
 		if err != nil {
 			return err
 		}
@@ -289,7 +334,7 @@ func ThreeButtons() templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_16 string = "Green"
+		var var_16 string = /*line template.templ:36:56*/ "Green" /*line template_templ.go:337:94*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_16))
 		if err != nil {
 			return err
@@ -298,17 +343,20 @@ func ThreeButtons() templ.Component {
 		if err != nil {
 			return err
 		}
-		err = MapCSSExample().Render(ctx, templBuffer)
+		err = /*line template.templ:37:4*/ MapCSSExample(). /*line template_templ.go:346:87*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "ThreeButtons", FileName: "template.templ", Line: 37, Col: 5}
 		}
-		err = KVExample().Render(ctx, templBuffer)
+		err = /*line template.templ:38:4*/ KVExample(). /*line template_templ.go:350:83*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "ThreeButtons", FileName: "template.templ", Line: 38, Col: 5}
 		}
-		err = PsuedoAttributes().Render(ctx, templBuffer)
+		err = /*line template.templ:39:4*/ PsuedoAttributes(). /*line template_templ.go:354:90*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "ThreeButtons", FileName: "template.templ", Line: 39, Col: 5}
+		}
+		if templRender != nil {
+			templRender.Rendered()
 		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
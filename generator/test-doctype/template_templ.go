@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func Layout(title, content string) templ.Component {
+func /*line template.templ:3:6*/ Layout(title, content string) /*line template_templ.go:12:96*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func Layout(title, content string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "Layout", "testdoctype", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -26,7 +31,7 @@ func Layout(title, content string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_2 string = title
+		var var_2 string = /*line template.templ:10:12*/ title /*line template_templ.go:34:90*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_2))
 		if err != nil {
 			return err
@@ -35,7 +40,7 @@ func Layout(title, content string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_3 string = content
+		var var_3 string = /*line template.templ:12:10*/ content /*line template_templ.go:43:92*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_3))
 		if err != nil {
 			return err
@@ -44,6 +49,9 @@ func Layout(title, content string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
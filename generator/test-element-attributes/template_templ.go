@@ -10,7 +10,7 @@ import "io"
 import "bytes"
 import "strings"
 
-func important() templ.CSSClass {
+func /*line template.templ:3:4*/ important /*line template_templ.go:13:76*/ () templ.CSSClass {
 	var templCSSBuilder strings.Builder
 	templCSSBuilder.WriteString(`width:100;`)
 	templCSSID := templ.CSSID(`important`, templCSSBuilder.String())
@@ -20,7 +20,7 @@ func important() templ.CSSClass {
 	}
 }
 
-func unimportant() templ.CSSClass {
+func /*line template.templ:7:4*/ unimportant /*line template_templ.go:23:78*/ () templ.CSSClass {
 	var templCSSBuilder strings.Builder
 	templCSSBuilder.WriteString(`width:50;`)
 	templCSSID := templ.CSSID(`unimportant`, templCSSBuilder.String())
@@ -30,7 +30,7 @@ func unimportant() templ.CSSClass {
 	}
 }
 
-func render(p person) templ.Component {
+func /*line template.templ:11:6*/ render(p person) /*line template_templ.go:33:84*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -38,26 +38,36 @@ func render(p person) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "render", "testelementattributes", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		var var_2 = []any{important()}
+		var var_2 = []any{ /*line template.templ:14:11*/ important() /*line template_templ.go:51:95*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_2...)
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("<div style=\"width: 100;\"")
+		_, err = templBuffer.WriteString("<div")
+		if err != nil {
+			return err
+		}
+		templ.AddStyleAttributeHash(ctx, "width: 100;")
+		_, err = templBuffer.WriteString(" style=\"width: 100;\"")
 		if err != nil {
 			return err
 		}
-		if p.important {
+		if /*line template.templ:13:5*/ p.important /*line template_templ.go:65:79*/ {
 			_, err = templBuffer.WriteString(" class=\"")
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_2).String()))
+			_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_2).String() /*line template_templ.go:70:148*/))
 			if err != nil {
 				return err
 			}
@@ -79,21 +89,26 @@ func render(p person) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_4 = []any{unimportant}
+		var var_4 = []any{ /*line template.templ:19:11*/ unimportant /*line template_templ.go:92:95*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_4...)
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("<div style=\"width: 100;\"")
+		_, err = templBuffer.WriteString("<div")
 		if err != nil {
 			return err
 		}
-		if !p.important {
+		templ.AddStyleAttributeHash(ctx, "width: 100;")
+		_, err = templBuffer.WriteString(" style=\"width: 100;\"")
+		if err != nil {
+			return err
+		}
+		if /*line template.templ:18:5*/ !p.important /*line template_templ.go:106:81*/ {
 			_, err = templBuffer.WriteString(" class=\"")
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_4).String()))
+			_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_4).String() /*line template_templ.go:111:149*/))
 			if err != nil {
 				return err
 			}
@@ -115,26 +130,31 @@ func render(p person) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_6 = []any{important}
+		var var_6 = []any{ /*line template.templ:24:11*/ important /*line template_templ.go:133:94*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_6...)
 		if err != nil {
 			return err
 		}
-		var var_7 = []any{unimportant}
+		var var_7 = []any{ /*line template.templ:26:11*/ unimportant /*line template_templ.go:138:96*/}
 		err = templ.RenderCSSItems(ctx, templBuffer, var_7...)
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString("<div style=\"width: 100;\"")
+		_, err = templBuffer.WriteString("<div")
 		if err != nil {
 			return err
 		}
-		if p.important {
+		templ.AddStyleAttributeHash(ctx, "width: 100;")
+		_, err = templBuffer.WriteString(" style=\"width: 100;\"")
+		if err != nil {
+			return err
+		}
+		if /*line template.templ:23:5*/ p.important /*line template_templ.go:152:80*/ {
 			_, err = templBuffer.WriteString(" class=\"")
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_6).String()))
+			_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_6).String() /*line template_templ.go:157:149*/))
 			if err != nil {
 				return err
 			}
@@ -147,7 +167,7 @@ func render(p person) templ.Component {
 			if err != nil {
 				return err
 			}
-			_, err = templBuffer.WriteString(templ.EscapeString(templ.CSSClasses(var_7).String()))
+			_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_7).String() /*line template_templ.go:170:149*/))
 			if err != nil {
 				return err
 			}
@@ -169,6 +189,9 @@ func render(p person) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func render(d data) templ.Component {
+func /*line template.templ:3:6*/ render(d data) /*line template_templ.go:12:81*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func render(d data) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "render", "elseif", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -26,20 +31,20 @@ func render(d data) templ.Component {
 		if err != nil {
 			return err
 		}
-		if d.IsTrue() {
-			var var_2 string = "True"
+		if /*line template.templ:5:5*/ d.IsTrue() /*line template_templ.go:34:77*/ {
+			var var_2 string = /*line template.templ:6:5*/ "True" /*line template_templ.go:35:90*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_2))
 			if err != nil {
 				return err
 			}
-		} else if !d.IsTrue() {
-			var var_3 string = "False"
+		} else if /*line template.templ:7:12*/ !d.IsTrue() /*line template_templ.go:40:86*/ {
+			var var_3 string = /*line template.templ:8:5*/ "False" /*line template_templ.go:41:91*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_3))
 			if err != nil {
 				return err
 			}
 		} else {
-			var var_4 string = "Else"
+			var var_4 string = /*line template.templ:10:5*/ "Else" /*line template_templ.go:47:91*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_4))
 			if err != nil {
 				return err
@@ -49,14 +54,14 @@ func render(d data) templ.Component {
 		if err != nil {
 			return err
 		}
-		if 1 == 2 {
-			var var_5 string = "If"
+		if /*line template.templ:14:5*/ 1 == 2 /*line template_templ.go:57:74*/ {
+			var var_5 string = /*line template.templ:15:5*/ "If" /*line template_templ.go:58:89*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_5))
 			if err != nil {
 				return err
 			}
-		} else if 1 == 1 {
-			var var_6 string = "ElseIf"
+		} else if /*line template.templ:16:12*/ 1 == 1 /*line template_templ.go:63:82*/ {
+			var var_6 string = /*line template.templ:17:5*/ "ElseIf" /*line template_templ.go:64:93*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_6))
 			if err != nil {
 				return err
@@ -66,26 +71,26 @@ func render(d data) templ.Component {
 		if err != nil {
 			return err
 		}
-		if 1 == 2 {
-			var var_7 string = "If"
+		if /*line template.templ:21:5*/ 1 == 2 /*line template_templ.go:74:74*/ {
+			var var_7 string = /*line template.templ:22:5*/ "If" /*line template_templ.go:75:89*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_7))
 			if err != nil {
 				return err
 			}
-		} else if 1 == 3 {
-			var var_8 string = "ElseIf"
+		} else if /*line template.templ:23:12*/ 1 == 3 /*line template_templ.go:80:82*/ {
+			var var_8 string = /*line template.templ:24:5*/ "ElseIf" /*line template_templ.go:81:93*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_8))
 			if err != nil {
 				return err
 			}
-		} else if 1 == 4 {
-			var var_9 string = "ElseIf"
+		} else if /*line template.templ:25:12*/ 1 == 4 /*line template_templ.go:86:82*/ {
+			var var_9 string = /*line template.templ:26:5*/ "ElseIf" /*line template_templ.go:87:93*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_9))
 			if err != nil {
 				return err
 			}
-		} else if 1 == 1 {
-			var var_10 string = "OK"
+		} else if /*line template.templ:27:12*/ 1 == 1 /*line template_templ.go:92:82*/ {
+			var var_10 string = /*line template.templ:28:5*/ "OK" /*line template_templ.go:93:90*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_10))
 			if err != nil {
 				return err
@@ -95,6 +100,9 @@ func render(d data) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
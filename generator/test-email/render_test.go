@@ -0,0 +1,31 @@
+package testemail
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test(t *testing.T) {
+	html := new(strings.Builder)
+	text := new(strings.Builder)
+	err := templ.RenderEmail(context.Background(), html, welcome("Alice", "https://example.com/confirm"), templ.WithEmailText(text))
+	if err != nil {
+		t.Fatalf("failed to render: %v", err)
+	}
+	expectedHTML := `<html><body>` +
+		`<h1 class="heading_1927" style="color:#333333;font-size:24px;">Welcome, Alice</h1>` +
+		`<p>Thanks for signing up.</p>` +
+		`<a class="button_3e28" style="background-color:#0066ff;color:#ffffff;padding: 8px" href="https://example.com/confirm">Confirm your email</a>` +
+		`</body></html>`
+	if diff := cmp.Diff(expectedHTML, html.String()); diff != "" {
+		t.Error(diff)
+	}
+	expectedText := "Welcome, Alice\n\nThanks for signing up.\n\nConfirm your email (https://example.com/confirm)\n"
+	if diff := cmp.Diff(expectedText, text.String()); diff != "" {
+		t.Error(diff)
+	}
+}
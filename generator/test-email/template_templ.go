@@ -0,0 +1,145 @@
+// Code generated by templ@(devel) DO NOT EDIT.
+
+package testemail
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+import "strings"
+
+func /*line template.templ:3:4*/ heading /*line template_templ.go:13:74*/ () templ.CSSClass {
+	var templCSSBuilder strings.Builder
+	templCSSBuilder.WriteString(`color:#333333;`)
+	templCSSBuilder.WriteString(`font-size:24px;`)
+	templCSSID := templ.CSSID(`heading`, templCSSBuilder.String())
+	return templ.ComponentCSSClass{
+		ID:    templCSSID,
+		Class: templ.SafeCSS(`.` + templCSSID + `{` + templCSSBuilder.String() + `}`),
+	}
+}
+
+func /*line template.templ:8:4*/ button /*line template_templ.go:24:73*/ () templ.CSSClass {
+	var templCSSBuilder strings.Builder
+	templCSSBuilder.WriteString(`background-color:#0066ff;`)
+	templCSSBuilder.WriteString(`color:#ffffff;`)
+	templCSSID := templ.CSSID(`button`, templCSSBuilder.String())
+	return templ.ComponentCSSClass{
+		ID:    templCSSID,
+		Class: templ.SafeCSS(`.` + templCSSID + `{` + templCSSBuilder.String() + `}`),
+	}
+}
+
+func /*line template.templ:13:6*/ welcome(name string, url string) /*line template_templ.go:35:101*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "welcome", "testemail", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<html><body>")
+		if err != nil {
+			return err
+		}
+		var var_2 = []any{ /*line template.templ:16:15*/ heading() /*line template_templ.go:57:93*/}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_2...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<h1 class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_2).String() /*line template_templ.go:66:147*/))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var_3 := `Welcome, `
+		_, err = templBuffer.WriteString(var_3)
+		if err != nil {
+			return err
+		}
+		var var_4 string = /*line template.templ:16:38*/ name /*line template_templ.go:79:89*/
+		_, err = templBuffer.WriteString(templ.EscapeString(var_4))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</h1><p>")
+		if err != nil {
+			return err
+		}
+		var_5 := `Thanks for signing up.`
+		_, err = templBuffer.WriteString(var_5)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</p>")
+		if err != nil {
+			return err
+		}
+		var var_6 = []any{ /*line template.templ:18:14*/ button() /*line template_templ.go:97:92*/}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_6...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<a class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_6).String() /*line template_templ.go:106:148*/))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"")
+		if err != nil {
+			return err
+		}
+		templ.AddStyleAttributeHash(ctx, "padding: 8px")
+		_, err = templBuffer.WriteString(" style=\"padding: 8px\" href=\"")
+		if err != nil {
+			return err
+		}
+		var var_7 templ.SafeURL = /*line template.templ:18:53*/ templ.URL(url) /*line template_templ.go:119:108*/
+		_, err = templBuffer.WriteString(templ.EscapeString(string(var_7)))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var_8 := `Confirm your email`
+		_, err = templBuffer.WriteString(var_8)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</a></body></html>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
@@ -0,0 +1,150 @@
+// Code generated by templ@(devel) DO NOT EDIT.
+
+package testforelse
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+func /*line template.templ:3:6*/ render(items []string, counts map[string]int, events chan string) /*line template_templ.go:12:133*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "render", "testforelse", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<ul>")
+		if err != nil {
+			return err
+		}
+		var_2 := false
+		for /*line template.templ:5:6*/ _, item := range items /*line template_templ.go:35:90*/ {
+			var_2 = true
+			_, err = templBuffer.WriteString("<li>")
+			if err != nil {
+				return err
+			}
+			var var_3 string = /*line template.templ:6:9*/ item /*line template_templ.go:41:88*/
+			_, err = templBuffer.WriteString(templ.EscapeString(var_3))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</li>")
+			if err != nil {
+				return err
+			}
+		}
+		if !var_2 {
+			_, err = templBuffer.WriteString("<li>")
+			if err != nil {
+				return err
+			}
+			var_4 := `No items`
+			_, err = templBuffer.WriteString(var_4)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</li>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</ul><dl>")
+		if err != nil {
+			return err
+		}
+		var_5 := false
+		for /*line template.templ:12:6*/ k := range counts /*line template_templ.go:71:86*/ {
+			var_5 = true
+			_, err = templBuffer.WriteString("<dt>")
+			if err != nil {
+				return err
+			}
+			var var_6 string = /*line template.templ:13:9*/ k /*line template_templ.go:77:86*/
+			_, err = templBuffer.WriteString(templ.EscapeString(var_6))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</dt>")
+			if err != nil {
+				return err
+			}
+		}
+		if !var_5 {
+			_, err = templBuffer.WriteString("<dt>")
+			if err != nil {
+				return err
+			}
+			var_7 := `No counts`
+			_, err = templBuffer.WriteString(var_7)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</dt>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</dl><ol>")
+		if err != nil {
+			return err
+		}
+		var_8 := false
+		for /*line template.templ:19:6*/ event := range events /*line template_templ.go:107:91*/ {
+			var_8 = true
+			_, err = templBuffer.WriteString("<li>")
+			if err != nil {
+				return err
+			}
+			var var_9 string = /*line template.templ:20:9*/ event /*line template_templ.go:113:91*/
+			_, err = templBuffer.WriteString(templ.EscapeString(var_9))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</li>")
+			if err != nil {
+				return err
+			}
+		}
+		if !var_8 {
+			_, err = templBuffer.WriteString("<li>")
+			if err != nil {
+				return err
+			}
+			var_10 := `No events`
+			_, err = templBuffer.WriteString(var_10)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</li>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</ol>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
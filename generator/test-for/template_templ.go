@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func render(items []string) templ.Component {
+func /*line template.templ:3:6*/ render(items []string) /*line template_templ.go:12:89*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,17 +17,22 @@ func render(items []string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "render", "testfor", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		for _, item := range items {
+		for /*line template.templ:4:5*/ _, item := range items /*line template_templ.go:30:90*/ {
 			_, err = templBuffer.WriteString("<div>")
 			if err != nil {
 				return err
 			}
-			var var_2 string = item
+			var var_2 string = /*line template.templ:5:9*/ item /*line template_templ.go:35:88*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_2))
 			if err != nil {
 				return err
@@ -37,6 +42,9 @@ func render(items []string) templ.Component {
 				return err
 			}
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -0,0 +1,68 @@
+package testfragment
+
+import (
+	"context"
+	_ "embed"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/a-h/templ/generator/htmldiff"
+	"github.com/google/go-cmp/cmp"
+)
+
+//go:embed expected.html
+var expected string
+
+func Test(t *testing.T) {
+	component := page([]string{"A", "B"})
+
+	t.Run("the full page is rendered by default", func(t *testing.T) {
+		diff, err := htmldiff.Diff(component, expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		names    []string
+		expected string
+	}{
+		{
+			name:     "a fragment is rendered with its nested fragments and the CSS it uses",
+			names:    []string{"items"},
+			expected: `<ul><style type="text/css">.highlight_050e{color:red;}</style><li class="highlight_050e">A</li><li class="highlight_050e">B</li></ul> <p>2 items</p>`,
+		},
+		{
+			name:     "a nested fragment can be rendered on its own",
+			names:    []string{"count"},
+			expected: `<p>2 items</p>`,
+		},
+		{
+			name:     "nested fragments are only rendered once",
+			names:    []string{"items", "count"},
+			expected: `<ul><style type="text/css">.highlight_050e{color:red;}</style><li class="highlight_050e">A</li><li class="highlight_050e">B</li></ul> <p>2 items</p>`,
+		},
+		{
+			name:     "unknown fragments render nothing",
+			names:    []string{"unknown"},
+			expected: ``,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			w := new(strings.Builder)
+			if err := templ.RenderFragments(context.Background(), w, component, tt.names...); err != nil {
+				t.Fatalf("failed to render fragments: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, w.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
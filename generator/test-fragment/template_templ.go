@@ -0,0 +1,174 @@
+// Code generated by templ@(devel) DO NOT EDIT.
+
+package testfragment
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+import "strings"
+
+//line template.templ:3:1
+import "fmt" /*line template_templ.go:14:46*/
+
+func /*line template.templ:5:4*/ highlight /*line template_templ.go:16:76*/ () templ.CSSClass {
+	var templCSSBuilder strings.Builder
+	templCSSBuilder.WriteString(`color:red;`)
+	templCSSID := templ.CSSID(`highlight`, templCSSBuilder.String())
+	return templ.ComponentCSSClass{
+		ID:    templCSSID,
+		Class: templ.SafeCSS(`.` + templCSSID + `{` + templCSSBuilder.String() + `}`),
+	}
+}
+
+func /*line template.templ:9:6*/ page(items []string) /*line template_templ.go:26:87*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "page", "testfragment", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<html><body>")
+		if err != nil {
+			return err
+		}
+		var var_2 = []any{ /*line template.templ:12:15*/ highlight() /*line template_templ.go:48:95*/}
+		err = templ.RenderCSSItems(ctx, templBuffer, var_2...)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("<h1 class=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_2).String() /*line template_templ.go:57:147*/))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var_3 := `Items`
+		_, err = templBuffer.WriteString(var_3)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</h1>")
+		if err != nil {
+			return err
+		}
+		var_4 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<ul>")
+			if err != nil {
+				return err
+			}
+			for /*line template.templ:15:9*/ _, item := range items /*line template_templ.go:84:92*/ {
+				var var_5 = []any{ /*line template.templ:16:18*/ highlight() /*line template_templ.go:85:97*/}
+				err = templ.RenderCSSItems(ctx, templBuffer, var_5...)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("<li class=\"")
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:1*/ templ.CSSClasses(var_5).String() /*line template_templ.go:94:149*/))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("\">")
+				if err != nil {
+					return err
+				}
+				var var_6 string = /*line template.templ:16:34*/ item /*line template_templ.go:102:92*/
+				_, err = templBuffer.WriteString(templ.EscapeString(var_6))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</li>")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString("</ul> ")
+			if err != nil {
+				return err
+			}
+			var_7 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+				templBuffer, templIsBuffer := w.(*bytes.Buffer)
+				if !templIsBuffer {
+					templBuffer = templ.GetBuffer()
+					defer templ.ReleaseBuffer(templBuffer)
+				}
+				_, err = templBuffer.WriteString("<p>")
+				if err != nil {
+					return err
+				}
+				var var_8 string = /*line template.templ:20:10*/ fmt.Sprint(len(items)) /*line template_templ.go:126:111*/
+				_, err = templBuffer.WriteString(templ.EscapeString(var_8))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(" ")
+				if err != nil {
+					return err
+				}
+				var_9 := `items`
+				_, err = templBuffer.WriteString(var_9)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</p>")
+				if err != nil {
+					return err
+				}
+				if !templIsBuffer {
+					_, err = io.Copy(w, templBuffer)
+				}
+				return err
+			})
+			err = templ.Fragment( /*line template.templ:19:19*/ "count" /*line template_templ.go:149:96*/).Render(templ.WithChildren(ctx, var_7), templBuffer)
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = templ.Fragment( /*line template.templ:13:18*/ "items" /*line template_templ.go:158:95*/).Render(templ.WithChildren(ctx, var_4), templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "page", FileName: "template.templ", Line: 13, Col: 19}
+		}
+		_, err = templBuffer.WriteString("</body></html>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
@@ -0,0 +1,290 @@
+// Code generated by templ@(devel) DO NOT EDIT.
+
+package testgenerics
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+//line template.templ:3:1
+import "fmt"
+
+type Column[T any] struct {
+	Name  string
+	Value func(T) string
+} /*line template_templ.go:18:35*/
+
+func /*line template.templ:10:6*/ Table[T any](rows []T, cols []Column[T]) /*line template_templ.go:20:109*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "Table", "testgenerics", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<table><tr>")
+		if err != nil {
+			return err
+		}
+		for /*line template.templ:13:7*/ _, col := range cols /*line template_templ.go:42:89*/ {
+			_, err = templBuffer.WriteString("<th>")
+			if err != nil {
+				return err
+			}
+			var var_2 string = /*line template.templ:14:10*/ col.Name /*line template_templ.go:47:94*/
+			_, err = templBuffer.WriteString(templ.EscapeString(var_2))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</th>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</tr>")
+		if err != nil {
+			return err
+		}
+		for /*line template.templ:17:6*/ _, row := range rows /*line template_templ.go:61:89*/ {
+			_, err = templBuffer.WriteString("<tr>")
+			if err != nil {
+				return err
+			}
+			for /*line template.templ:19:8*/ _, col := range cols /*line template_templ.go:66:90*/ {
+				_, err = templBuffer.WriteString("<td>")
+				if err != nil {
+					return err
+				}
+				var var_3 string = /*line template.templ:20:11*/ col.Value(row) /*line template_templ.go:71:102*/
+				_, err = templBuffer.WriteString(templ.EscapeString(var_3))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</td>")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString("</tr>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</table>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+//line template.templ:27:1
+type List[T fmt.Stringer] struct {
+	Items []T
+} /*line template_templ.go:103:36*/
+
+func /*line template.templ:31:6*/ (l List[T]) Render(title string) /*line template_templ.go:105:102*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "Render", "testgenerics", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_4 := templ.GetChildren(ctx)
+		if var_4 == nil {
+			var_4 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<h2>")
+		if err != nil {
+			return err
+		}
+		var var_5 string = /*line template.templ:32:7*/ title /*line template_templ.go:127:90*/
+		_, err = templBuffer.WriteString(templ.EscapeString(var_5))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</h2><ul>")
+		if err != nil {
+			return err
+		}
+		for /*line template.templ:34:6*/ _, item := range l.Items /*line template_templ.go:136:94*/ {
+			_, err = templBuffer.WriteString("<li>")
+			if err != nil {
+				return err
+			}
+			var var_6 string = /*line template.templ:35:9*/ item.String() /*line template_templ.go:141:99*/
+			_, err = templBuffer.WriteString(templ.EscapeString(var_6))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</li>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</ul>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func /*line template.templ:40:6*/ Panel[T any](value T) /*line template_templ.go:165:90*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "Panel", "testgenerics", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_7 := templ.GetChildren(ctx)
+		if var_7 == nil {
+			var_7 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<section><h2>")
+		if err != nil {
+			return err
+		}
+		var var_8 string = /*line template.templ:42:8*/ fmt.Sprint(value) /*line template_templ.go:187:103*/
+		_, err = templBuffer.WriteString(templ.EscapeString(var_8))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</h2>")
+		if err != nil {
+			return err
+		}
+		err = var_7.Render(ctx, templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "Panel", FileName: "template.templ"}
+		}
+		_, err = templBuffer.WriteString("</section>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+//line template.templ:47:1
+type user struct {
+	Name string
+	Age  int
+}
+
+func (u user) String() string {
+	return u.Name
+} /*line template_templ.go:222:36*/
+
+func /*line template.templ:56:6*/ page(users []user) /*line template_templ.go:224:87*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "page", "testgenerics", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_9 := templ.GetChildren(ctx)
+		if var_9 == nil {
+			var_9 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		err = /*line template.templ:57:2*/ Table[user](users, []Column[user]{
+			{Name: "Name", Value: func(u user) string { return u.Name }},
+			{Name: "Age", Value: func(u user) string { return fmt.Sprint(u.Age) }},
+		}). /*line template_templ.go:245:38*/ Render(ctx, templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "page", FileName: "template.templ", Line: 57, Col: 3}
+		}
+		/*line template.templ:61:4*/ list := List[user]{Items: users} /*line template_templ.go:249:98*/
+		err = /*line template.templ:62:2*/ list.Render("Users"). /*line template_templ.go:250:92*/ Render(ctx, templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "page", FileName: "template.templ", Line: 62, Col: 3}
+		}
+		var_10 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<p>")
+			if err != nil {
+				return err
+			}
+			var_11 := `users`
+			_, err = templBuffer.WriteString(var_11)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</p>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = /*line template.templ:63:2*/ Panel[int](len(users)). /*line template_templ.go:278:94*/ Render(templ.WithChildren(ctx, var_10), templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "page", FileName: "template.templ", Line: 63, Col: 3}
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
@@ -0,0 +1,89 @@
+// Code generated by templ@(devel) DO NOT EDIT.
+
+package testgocode
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+//line template.templ:3:1
+import (
+	"strconv"
+	"strings"
+)
+
+type item struct {
+	Name  string
+	Price int
+} /*line template_templ.go:21:35*/
+
+func /*line template.templ:13:6*/ basket(items []item) /*line template_templ.go:23:88*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "basket", "testgocode", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		/*line template.templ:14:4*/ total := 0 /*line template_templ.go:41:75*/
+		_, err = templBuffer.WriteString("<ul>")
+		if err != nil {
+			return err
+		}
+		for /*line template.templ:16:6*/ _, item := range items /*line template_templ.go:46:91*/ {
+			/*line template.templ:18:4*/ total += item.Price
+			name := strings.ToUpper(item.Name) /*line template_templ.go:48:72*/
+			_, err = templBuffer.WriteString("<li>")
+			if err != nil {
+				return err
+			}
+			var var_2 string = /*line template.templ:21:9*/ name /*line template_templ.go:53:89*/
+			_, err = templBuffer.WriteString(templ.EscapeString(var_2))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</li>")
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString("</ul><p>")
+		if err != nil {
+			return err
+		}
+		var_3 := `Total: `
+		_, err = templBuffer.WriteString(var_3)
+		if err != nil {
+			return err
+		}
+		var var_4 string = /*line template.templ:24:13*/ strconv.Itoa(total) /*line template_templ.go:72:105*/
+		_, err = templBuffer.WriteString(templ.EscapeString(var_4))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</p>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
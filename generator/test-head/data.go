@@ -0,0 +1,6 @@
+package testhead
+
+type Product struct {
+	Name string
+	URL  string
+}
@@ -0,0 +1,57 @@
+package testhead
+
+import (
+	"context"
+	_ "embed"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/a-h/templ/generator/htmldiff"
+)
+
+//go:embed expected.html
+var expected string
+
+func Test(t *testing.T) {
+	component := product(Product{
+		Name: "Lamp",
+		URL:  "https://example.com/products/lamp",
+	})
+
+	t.Run("head content is added to the outlet by RenderWithHead", func(t *testing.T) {
+		withHead := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			return templ.RenderWithHead(ctx, w, component)
+		})
+		diff, err := htmldiff.Diff(withHead, expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff != "" {
+			t.Error(diff)
+		}
+	})
+	for _, streaming := range []bool{false, true} {
+		h := templ.Handler(component)
+		h.Streaming = streaming
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		body := w.Body.String()
+		raw := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			_, err := io.WriteString(w, body)
+			return err
+		})
+		diff, err := htmldiff.Diff(raw, expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff != "" {
+			t.Errorf("streaming %v: %s", streaming, diff)
+		}
+		if strings.Contains(body, "<title>Shop</title>") {
+			t.Errorf("streaming %v: expected the layout's title to be replaced", streaming)
+		}
+	}
+}
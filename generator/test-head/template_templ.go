@@ -0,0 +1,241 @@
+// Code generated by templ@(devel) DO NOT EDIT.
+
+package testhead
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+func /*line template.templ:3:6*/ layout() /*line template_templ.go:12:75*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "layout", "testhead", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<html><head>")
+		if err != nil {
+			return err
+		}
+		err = /*line template.templ:6:4*/ templ.HeadOutlet(). /*line template_templ.go:34:88*/ Render(ctx, templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "layout", FileName: "template.templ", Line: 6, Col: 5}
+		}
+		var_2 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<title>")
+			if err != nil {
+				return err
+			}
+			var_3 := `Shop`
+			_, err = templBuffer.WriteString(var_3)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</title>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = /*line template.templ:7:4*/ templ.Head("title"). /*line template_templ.go:62:89*/ Render(templ.WithChildren(ctx, var_2), templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "layout", FileName: "template.templ", Line: 7, Col: 5}
+		}
+		_, err = templBuffer.WriteString("</head><body>")
+		if err != nil {
+			return err
+		}
+		err = var_1.Render(ctx, templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "layout", FileName: "template.templ"}
+		}
+		_, err = templBuffer.WriteString("</body></html>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func /*line template.templ:17:6*/ product(p Product) /*line template_templ.go:88:86*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "product", "testhead", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_4 := templ.GetChildren(ctx)
+		if var_4 == nil {
+			var_4 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var_5 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			var_6 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+				templBuffer, templIsBuffer := w.(*bytes.Buffer)
+				if !templIsBuffer {
+					templBuffer = templ.GetBuffer()
+					defer templ.ReleaseBuffer(templBuffer)
+				}
+				_, err = templBuffer.WriteString("<title>")
+				if err != nil {
+					return err
+				}
+				var var_7 string = /*line template.templ:20:12*/ p.Name /*line template_templ.go:122:94*/
+				_, err = templBuffer.WriteString(templ.EscapeString(var_7))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(" ")
+				if err != nil {
+					return err
+				}
+				var_8 := `- Shop`
+				_, err = templBuffer.WriteString(var_8)
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</title>")
+				if err != nil {
+					return err
+				}
+				if !templIsBuffer {
+					_, err = io.Copy(w, templBuffer)
+				}
+				return err
+			})
+			err = /*line template.templ:19:3*/ templ.Head("title"). /*line template_templ.go:145:92*/ Render(templ.WithChildren(ctx, var_6), templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(" ")
+			if err != nil {
+				return err
+			}
+			var_9 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+				templBuffer, templIsBuffer := w.(*bytes.Buffer)
+				if !templIsBuffer {
+					templBuffer = templ.GetBuffer()
+					defer templ.ReleaseBuffer(templBuffer)
+				}
+				_, err = templBuffer.WriteString("<meta property=\"og:title\" content=\"")
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:23:39*/ p.Name /*line template_templ.go:163:128*/))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("\">")
+				if err != nil {
+					return err
+				}
+				if !templIsBuffer {
+					_, err = io.Copy(w, templBuffer)
+				}
+				return err
+			})
+			err = /*line template.templ:22:3*/ templ.Head("meta:og:title"). /*line template_templ.go:176:101*/ Render(templ.WithChildren(ctx, var_9), templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(" ")
+			if err != nil {
+				return err
+			}
+			var_10 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+				templBuffer, templIsBuffer := w.(*bytes.Buffer)
+				if !templIsBuffer {
+					templBuffer = templ.GetBuffer()
+					defer templ.ReleaseBuffer(templBuffer)
+				}
+				_, err = templBuffer.WriteString("<link rel=\"canonical\" href=\"")
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:26:32*/ templ.URL(p.URL) /*line template_templ.go:194:172*/))))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("\">")
+				if err != nil {
+					return err
+				}
+				if !templIsBuffer {
+					_, err = io.Copy(w, templBuffer)
+				}
+				return err
+			})
+			err = /*line template.templ:25:3*/ templ.Head("link:canonical"). /*line template_templ.go:207:102*/ Render(templ.WithChildren(ctx, var_10), templBuffer)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(" <h1>")
+			if err != nil {
+				return err
+			}
+			var var_11 string = /*line template.templ:28:8*/ p.Name /*line template_templ.go:215:93*/
+			_, err = templBuffer.WriteString(templ.EscapeString(var_11))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</h1>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = /*line template.templ:18:2*/ layout(). /*line template_templ.go:229:80*/ Render(templ.WithChildren(ctx, var_5), templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "product", FileName: "template.templ", Line: 18, Col: 3}
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
@@ -1,10 +1,16 @@
 package testhtml
 
 import (
+	"crypto/sha256"
 	_ "embed"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/a-h/templ"
 	"github.com/a-h/templ/generator/htmldiff"
+	"github.com/google/go-cmp/cmp"
 )
 
 //go:embed expected.html
@@ -24,3 +30,34 @@ func Test(t *testing.T) {
 		t.Error(diff)
 	}
 }
+
+func TestContentSecurityPolicy(t *testing.T) {
+	component := render(person{
+		name:  "Luiz Bonfa",
+		email: "luiz@example.com",
+	})
+	// Browsers hash the value of the style attribute after decoding it.
+	sum := sha256.Sum256([]byte("font-family: 'sans-serif'"))
+	styleHash := "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+
+	t.Run("the hash policy allows the style attribute", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		templ.NewCSPMiddleware(templ.Handler(component)).ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		expected := "script-src 'self'; style-src 'self' 'unsafe-hashes' " + styleHash
+		if diff := cmp.Diff(expected, w.Header().Get("Content-Security-Policy")); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("the nonce policy allows the style attribute", func(t *testing.T) {
+		var nonce string
+		w := httptest.NewRecorder()
+		templ.NewNonceMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			nonce = templ.GetNonce(r.Context())
+			templ.Handler(component).ServeHTTP(w, r)
+		})).ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		expected := "script-src 'nonce-" + nonce + "'; style-src 'nonce-" + nonce + "' 'unsafe-hashes' " + styleHash
+		if diff := cmp.Diff(expected, w.Header().Get("Content-Security-Policy")); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func render(d data) templ.Component {
+func /*line template.templ:3:6*/ render(d data) /*line template_templ.go:12:81*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,24 +17,32 @@ func render(d data) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "render", "testif", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		if d.IsTrue() {
-			var var_2 string = "True"
+		if /*line template.templ:4:4*/ d.IsTrue() /*line template_templ.go:30:77*/ {
+			var var_2 string = /*line template.templ:5:4*/ "True" /*line template_templ.go:31:90*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_2))
 			if err != nil {
 				return err
 			}
 		} else {
-			var var_3 string = "False"
+			var var_3 string = /*line template.templ:7:4*/ "False" /*line template_templ.go:37:91*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_3))
 			if err != nil {
 				return err
 			}
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func listItem() templ.Component {
+func /*line template.templ:3:6*/ listItem() /*line template_templ.go:12:77*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func listItem() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "listItem", "testimport", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -28,12 +33,15 @@ func listItem() templ.Component {
 		}
 		err = var_1.Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "listItem", FileName: "template.templ"}
 		}
 		_, err = templBuffer.WriteString("</li>")
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -41,7 +49,7 @@ func listItem() templ.Component {
 	})
 }
 
-func list() templ.Component {
+func /*line template.templ:7:6*/ list() /*line template_templ.go:52:73*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -49,6 +57,11 @@ func list() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "list", "testimport", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_2 := templ.GetChildren(ctx)
 		if var_2 == nil {
 			var_2 = templ.NopComponent
@@ -60,12 +73,15 @@ func list() templ.Component {
 		}
 		err = var_2.Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "list", FileName: "template.templ"}
 		}
 		_, err = templBuffer.WriteString("</ul>")
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -73,7 +89,7 @@ func list() templ.Component {
 	})
 }
 
-func main() templ.Component {
+func /*line template.templ:13:6*/ main() /*line template_templ.go:92:74*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -81,6 +97,11 @@ func main() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "main", "testimport", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_3 := templ.GetChildren(ctx)
 		if var_3 == nil {
 			var_3 = templ.NopComponent
@@ -116,7 +137,7 @@ func main() templ.Component {
 				}
 				return err
 			})
-			err = listItem().Render(templ.WithChildren(ctx, var_5), templBuffer)
+			err = /*line template.templ:15:3*/ listItem(). /*line template_templ.go:140:83*/ Render(templ.WithChildren(ctx, var_5), templBuffer)
 			if err != nil {
 				return err
 			}
@@ -148,7 +169,7 @@ func main() templ.Component {
 				}
 				return err
 			})
-			err = listItem().Render(templ.WithChildren(ctx, var_7), templBuffer)
+			err = /*line template.templ:18:3*/ listItem(). /*line template_templ.go:172:83*/ Render(templ.WithChildren(ctx, var_7), templBuffer)
 			if err != nil {
 				return err
 			}
@@ -180,7 +201,7 @@ func main() templ.Component {
 				}
 				return err
 			})
-			err = listItem().Render(templ.WithChildren(ctx, var_9), templBuffer)
+			err = /*line template.templ:21:3*/ listItem(). /*line template_templ.go:204:83*/ Render(templ.WithChildren(ctx, var_9), templBuffer)
 			if err != nil {
 				return err
 			}
@@ -189,9 +210,12 @@ func main() templ.Component {
 			}
 			return err
 		})
-		err = list().Render(templ.WithChildren(ctx, var_4), templBuffer)
+		err = /*line template.templ:14:2*/ list(). /*line template_templ.go:213:78*/ Render(templ.WithChildren(ctx, var_4), templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "main", FileName: "template.templ", Line: 14, Col: 3}
+		}
+		if templRender != nil {
+			templRender.Rendered()
 		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
@@ -0,0 +1,101 @@
+package testobserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+type depthKey struct{}
+
+type recorder struct {
+	events []string
+}
+
+func (r *recorder) OnRenderStart(ctx context.Context, info templ.RenderInfo) context.Context {
+	depth, _ := ctx.Value(depthKey{}).(int)
+	r.events = append(r.events, fmt.Sprintf("%sstart %s.%s", strings.Repeat(" ", depth), info.Package, info.Name))
+	return context.WithValue(ctx, depthKey{}, depth+1)
+}
+
+func (r *recorder) OnRenderEnd(ctx context.Context, info templ.RenderInfo, bytesWritten int, err error) {
+	depth := ctx.Value(depthKey{}).(int) - 1
+	r.events = append(r.events, fmt.Sprintf("%send %s.%s %d bytes, err: %v", strings.Repeat(" ", depth), info.Package, info.Name, bytesWritten, err))
+}
+
+func Test(t *testing.T) {
+	t.Run("templates notify the observer when they start and end", func(t *testing.T) {
+		r := &recorder{}
+		ctx := templ.WithObserver(context.Background(), r)
+		w := new(strings.Builder)
+		if err := page([]string{"a", "b"}).Render(ctx, w); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if diff := cmp.Diff("<ul><li>a</li><li>b</li></ul>", w.String()); diff != "" {
+			t.Error(diff)
+		}
+		expected := []string{
+			"start testobserver.page",
+			" start testobserver.listItem",
+			" end testobserver.listItem 10 bytes, err: <nil>",
+			" start testobserver.listItem",
+			" end testobserver.listItem 10 bytes, err: <nil>",
+			"end testobserver.page 29 bytes, err: <nil>",
+		}
+		if diff := cmp.Diff(expected, r.events); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("errors are passed to the observer", func(t *testing.T) {
+		r := &recorder{}
+		ctx := templ.WithObserver(context.Background(), r)
+		errFailed := errors.New("failed")
+		if err := broken(errFailed).Render(ctx, io.Discard); !errors.Is(err, errFailed) {
+			t.Fatalf("expected the error to be returned, got %v", err)
+		}
+		expected := []string{
+			"start testobserver.broken",
+			"end testobserver.broken 5 bytes, err: broken at template.templ:17:4: failed",
+		}
+		if diff := cmp.Diff(expected, r.events); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("bytes written by Flush are counted", func(t *testing.T) {
+		r := &recorder{}
+		req := httptest.NewRequest("GET", "/", nil)
+		req = req.WithContext(templ.WithObserver(req.Context(), r))
+		w := httptest.NewRecorder()
+		templ.ComponentHandler{Component: streamed([]string{"a", "b"}), ContentType: "text/html", Streaming: true}.ServeHTTP(w, req)
+		if diff := cmp.Diff("<ul><li>a</li> <li>b</li> </ul>", w.Body.String()); diff != "" {
+			t.Error(diff)
+		}
+		expected := []string{
+			"start testobserver.streamed",
+			" start testobserver.listItem",
+			" end testobserver.listItem 10 bytes, err: <nil>",
+			" start testobserver.listItem",
+			" end testobserver.listItem 10 bytes, err: <nil>",
+			"end testobserver.streamed 31 bytes, err: <nil>",
+		}
+		if diff := cmp.Diff(expected, r.events); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("templates render without an observer", func(t *testing.T) {
+		w := new(strings.Builder)
+		if err := page([]string{"a"}).Render(context.Background(), w); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if diff := cmp.Diff("<ul><li>a</li></ul>", w.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
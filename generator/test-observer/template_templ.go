@@ -0,0 +1,187 @@
+// Code generated by templ@(devel) DO NOT EDIT.
+
+package testobserver
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+func /*line template.templ:3:6*/ page(items []string) /*line template_templ.go:12:87*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "page", "testobserver", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<ul>")
+		if err != nil {
+			return err
+		}
+		for /*line template.templ:5:6*/ _, item := range items /*line template_templ.go:34:90*/ {
+			err = /*line template.templ:6:4*/ listItem(item). /*line template_templ.go:35:85*/ Render(ctx, templBuffer)
+			if err != nil {
+				return &templ.Error{Err: err, Name: "page", FileName: "template.templ", Line: 6, Col: 5}
+			}
+		}
+		_, err = templBuffer.WriteString("</ul>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func /*line template.templ:11:6*/ listItem(item string) /*line template_templ.go:54:89*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "listItem", "testobserver", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_2 := templ.GetChildren(ctx)
+		if var_2 == nil {
+			var_2 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<li>")
+		if err != nil {
+			return err
+		}
+		var var_3 string = /*line template.templ:12:7*/ item /*line template_templ.go:76:88*/
+		_, err = templBuffer.WriteString(templ.EscapeString(var_3))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</li>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func /*line template.templ:15:6*/ broken(cause error) /*line template_templ.go:95:87*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "broken", "testobserver", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_4 := templ.GetChildren(ctx)
+		if var_4 == nil {
+			var_4 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div>")
+		if err != nil {
+			return err
+		}
+		err = /*line template.templ:17:3*/ fail(cause). /*line template_templ.go:117:83*/ Render(ctx, templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "broken", FileName: "template.templ", Line: 17, Col: 4}
+		}
+		_, err = templBuffer.WriteString("</div>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func /*line template.templ:21:6*/ streamed(items []string) /*line template_templ.go:135:93*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "streamed", "testobserver", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_5 := templ.GetChildren(ctx)
+		if var_5 == nil {
+			var_5 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<ul>")
+		if err != nil {
+			return err
+		}
+		err = /*line template.templ:23:3*/ templ.Flush(). /*line template_templ.go:157:85*/ Render(ctx, templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "streamed", FileName: "template.templ", Line: 23, Col: 4}
+		}
+		for /*line template.templ:24:6*/ _, item := range items /*line template_templ.go:161:92*/ {
+			err = /*line template.templ:25:4*/ listItem(item). /*line template_templ.go:162:87*/ Render(ctx, templBuffer)
+			if err != nil {
+				return &templ.Error{Err: err, Name: "streamed", FileName: "template.templ", Line: 25, Col: 5}
+			}
+			_, err = templBuffer.WriteString(" ")
+			if err != nil {
+				return err
+			}
+			err = /*line template.templ:26:4*/ templ.Flush(). /*line template_templ.go:170:86*/ Render(ctx, templBuffer)
+			if err != nil {
+				return &templ.Error{Err: err, Name: "streamed", FileName: "template.templ", Line: 26, Col: 5}
+			}
+		}
+		_, err = templBuffer.WriteString("</ul>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
@@ -0,0 +1,141 @@
+// Code generated by templ@(devel) DO NOT EDIT.
+
+package testonce
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+func /*line template.templ:3:6*/ chart(name string) /*line template_templ.go:12:85*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "chart", "testonce", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var_2 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<script src=\"https://example.com/chart.js\">")
+			if err != nil {
+				return err
+			}
+			var_3 := ``
+			_, err = templBuffer.WriteString(var_3)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</script>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = templ.Once( /*line template.templ:4:12*/ "chart" /*line template_templ.go:54:89*/).Render(templ.WithChildren(ctx, var_2), templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "chart", FileName: "template.templ", Line: 4, Col: 13}
+		}
+		_, err = templBuffer.WriteString("<div class=\"chart\">")
+		if err != nil {
+			return err
+		}
+		var var_4 string = /*line template.templ:7:22*/ name /*line template_templ.go:62:88*/
+		_, err = templBuffer.WriteString(templ.EscapeString(var_4))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</div>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func /*line template.templ:10:6*/ page(names []string) /*line template_templ.go:81:88*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "page", "testonce", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_5 := templ.GetChildren(ctx)
+		if var_5 == nil {
+			var_5 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		for /*line template.templ:11:5*/ _, name := range names /*line template_templ.go:99:91*/ {
+			err = /*line template.templ:12:3*/ chart(name). /*line template_templ.go:100:84*/ Render(ctx, templBuffer)
+			if err != nil {
+				return &templ.Error{Err: err, Name: "page", FileName: "template.templ", Line: 12, Col: 4}
+			}
+		}
+		var_6 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<p>")
+			if err != nil {
+				return err
+			}
+			var_7 := `The block syntax and the component share the same keys.`
+			_, err = templBuffer.WriteString(var_7)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</p>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = /*line template.templ:14:2*/ templ.Once("chart"). /*line template_templ.go:129:91*/ Render(templ.WithChildren(ctx, var_6), templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "page", FileName: "template.templ", Line: 14, Col: 3}
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
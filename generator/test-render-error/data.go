@@ -0,0 +1,14 @@
+package testrendererror
+
+import (
+	"context"
+	"io"
+
+	"github.com/a-h/templ"
+)
+
+func fail(err error) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return err
+	})
+}
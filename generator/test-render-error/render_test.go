@@ -0,0 +1,34 @@
+package testrendererror
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func Test(t *testing.T) {
+	errFailed := errors.New("failed")
+	component := page(card(errFailed))
+
+	err := component.Render(context.Background(), io.Discard)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	expected := "page > layout > card at template.templ:19:4: failed"
+	if err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err.Error())
+	}
+	if !errors.Is(err, errFailed) {
+		t.Error("expected the underlying error to be unwrapped")
+	}
+	var templErr *templ.Error
+	if !errors.As(err, &templErr) {
+		t.Fatal("expected a templ.Error")
+	}
+	if templErr.Name != "page" || templErr.Line != 5 {
+		t.Errorf("expected the outer error to be the layout call in page, got %q at line %d", templErr.Name, templErr.Line)
+	}
+}
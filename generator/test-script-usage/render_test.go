@@ -1,10 +1,17 @@
 package testscriptusage
 
 import (
+	"crypto/sha256"
 	_ "embed"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/a-h/templ"
 	"github.com/a-h/templ/generator/htmldiff"
+	"github.com/google/go-cmp/cmp"
 )
 
 //go:embed expected.html
@@ -21,3 +28,35 @@ func Test(t *testing.T) {
 		t.Error(diff)
 	}
 }
+
+func TestNonce(t *testing.T) {
+	var nonce string
+	h := templ.NewNonceMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nonce = templ.GetNonce(r.Context())
+		templ.Handler(Button("A")).ServeHTTP(w, r)
+	}))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	// The script element that defines the functions has the nonce.
+	expectedScript := `<script type="text/javascript" nonce="` + nonce + `">function __templ_withParameters_1056(a, b, c){console.log(a, b, c);}function __templ_withoutParameters_6bbf(){alert("hello");}</script>`
+	if !strings.HasPrefix(w.Body.String(), expectedScript) {
+		t.Errorf("expected the script element to have the nonce, got:\n%s", w.Body.String())
+	}
+	expectedButton := `<button onClick="__templ_withParameters_1056(&#34;test&#34;,&#34;A&#34;,123)" onMouseover="__templ_withoutParameters_6bbf()" type="button">A</button>`
+	if !strings.HasSuffix(w.Body.String(), expectedButton) {
+		t.Errorf("expected the event handlers to be rendered inline, got:\n%s", w.Body.String())
+	}
+	// A nonce doesn't apply to the event handlers that call the functions, so they're allowed
+	// by the hashes of their decoded values.
+	expectedPolicy := "script-src 'nonce-" + nonce + "' 'unsafe-hashes' " +
+		hash(`__templ_withParameters_1056("test","A",123)`) + " " + hash(`__templ_withoutParameters_6bbf()`) +
+		"; style-src 'nonce-" + nonce + "'"
+	if diff := cmp.Diff(expectedPolicy, w.Header().Get("Content-Security-Policy")); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+}
@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func withParameters(a string, b string, c int) templ.ComponentScript {
+func /*line template.templ:3:7*/ withParameters /*line template_templ.go:12:81*/ ( /*line template.templ:3:22*/ a string, b string, c int /*line template_templ.go:12:170*/) templ.ComponentScript {
 	return templ.ComponentScript{
 		Name:     `__templ_withParameters_1056`,
 		Function: `function __templ_withParameters_1056(a, b, c){console.log(a, b, c);}`,
@@ -17,7 +17,7 @@ func withParameters(a string, b string, c int) templ.ComponentScript {
 	}
 }
 
-func withoutParameters() templ.ComponentScript {
+func /*line template.templ:7:7*/ withoutParameters /*line template_templ.go:20:84*/ ( /*line template.templ:7:25*/ /*line template_templ.go:20:148*/ ) templ.ComponentScript {
 	return templ.ComponentScript{
 		Name:     `__templ_withoutParameters_6bbf`,
 		Function: `function __templ_withoutParameters_6bbf(){alert("hello");}`,
@@ -25,7 +25,7 @@ func withoutParameters() templ.ComponentScript {
 	}
 }
 
-func onClick() templ.ComponentScript {
+func /*line template.templ:11:7*/ onClick /*line template_templ.go:28:75*/ ( /*line template.templ:11:15*/ /*line template_templ.go:28:140*/ ) templ.ComponentScript {
 	return templ.ComponentScript{
 		Name:     `__templ_onClick_657d`,
 		Function: `function __templ_onClick_657d(){alert("clicked");}`,
@@ -33,7 +33,7 @@ func onClick() templ.ComponentScript {
 	}
 }
 
-func Button(text string) templ.Component {
+func /*line template.templ:15:6*/ Button(text string) /*line template_templ.go:36:87*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -41,6 +41,11 @@ func Button(text string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "Button", "testscriptusage", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -54,8 +59,8 @@ func Button(text string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_2 templ.ComponentScript = withParameters("test", text, 123)
-		_, err = templBuffer.WriteString(var_2.Call)
+		var var_2 templ.ComponentScript = /*line template.templ:16:19*/ withParameters("test", text, 123) /*line template_templ.go:62:134*/
+		err = templ.RenderScriptCall(ctx, templBuffer, var_2)
 		if err != nil {
 			return err
 		}
@@ -63,8 +68,8 @@ func Button(text string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_3 templ.ComponentScript = withoutParameters()
-		_, err = templBuffer.WriteString(var_3.Call)
+		var var_3 templ.ComponentScript = /*line template.templ:16:69*/ withoutParameters() /*line template_templ.go:71:120*/
+		err = templ.RenderScriptCall(ctx, templBuffer, var_3)
 		if err != nil {
 			return err
 		}
@@ -72,7 +77,7 @@ func Button(text string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_4 string = text
+		var var_4 string = /*line template.templ:16:107*/ text /*line template_templ.go:80:90*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_4))
 		if err != nil {
 			return err
@@ -81,6 +86,9 @@ func Button(text string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -88,7 +96,7 @@ func Button(text string) templ.Component {
 	})
 }
 
-func ThreeButtons() templ.Component {
+func /*line template.templ:19:6*/ ThreeButtons() /*line template_templ.go:99:82*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -96,18 +104,23 @@ func ThreeButtons() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "ThreeButtons", "testscriptusage", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_5 := templ.GetChildren(ctx)
 		if var_5 == nil {
 			var_5 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		err = Button("A").Render(ctx, templBuffer)
+		err = /*line template.templ:20:4*/ Button("A"). /*line template_templ.go:117:83*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "ThreeButtons", FileName: "template.templ", Line: 20, Col: 5}
 		}
-		err = Button("B").Render(ctx, templBuffer)
+		err = /*line template.templ:21:2*/ Button("B"). /*line template_templ.go:121:83*/ Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "ThreeButtons", FileName: "template.templ", Line: 21, Col: 3}
 		}
 		_, err = templBuffer.WriteString("<button onMouseover=\"console.log(&#39;mouseover&#39;)\" type=\"button\">")
 		if err != nil {
@@ -139,8 +152,8 @@ func ThreeButtons() templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_8 templ.ComponentScript = onClick()
-		_, err = templBuffer.WriteString(var_8.Call)
+		var var_8 templ.ComponentScript = /*line template.templ:24:24*/ onClick() /*line template_templ.go:155:111*/
+		err = templ.RenderScriptCall(ctx, templBuffer, var_8)
 		if err != nil {
 			return err
 		}
@@ -157,6 +170,9 @@ func ThreeButtons() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -0,0 +1,261 @@
+// Code generated by templ@(devel) DO NOT EDIT.
+
+package testslots
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+func /*line template.templ:3:6*/ card() /*line template_templ.go:12:73*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "card", "testslots", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		var_2 := templ.GetSlots(ctx)
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div class=\"card\"><div class=\"header\">")
+		if err != nil {
+			return err
+		}
+		err = var_2.Get( /*line template.templ:5:29*/ "header", defaultHeader() /*line template_templ.go:35:107*/).Render(ctx, templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "card", FileName: "template.templ", Line: 5, Col: 30}
+		}
+		_, err = templBuffer.WriteString("</div><div class=\"body\">")
+		if err != nil {
+			return err
+		}
+		err = var_1.Render(ctx, templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "card", FileName: "template.templ"}
+		}
+		_, err = templBuffer.WriteString("</div><div class=\"footer\">")
+		if err != nil {
+			return err
+		}
+		err = var_2.Get( /*line template.templ:7:29*/ "footer" /*line template_templ.go:51:89*/).Render(ctx, templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "card", FileName: "template.templ", Line: 7, Col: 30}
+		}
+		_, err = templBuffer.WriteString("</div></div>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func /*line template.templ:11:6*/ defaultHeader() /*line template_templ.go:69:83*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "defaultHeader", "testslots", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_3 := templ.GetChildren(ctx)
+		if var_3 == nil {
+			var_3 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<h2>")
+		if err != nil {
+			return err
+		}
+		var_4 := `Untitled`
+		_, err = templBuffer.WriteString(var_4)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</h2>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
+
+func /*line template.templ:15:6*/ page(title string, items []string) /*line template_templ.go:110:104*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "page", "testslots", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_5 := templ.GetChildren(ctx)
+		if var_5 == nil {
+			var_5 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		var_6 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<h1>")
+			if err != nil {
+				return err
+			}
+			var var_7 string = /*line template.templ:18:9*/ title /*line template_templ.go:138:91*/
+			_, err = templBuffer.WriteString(templ.EscapeString(var_7))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</h1>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		var_8 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<p>")
+			if err != nil {
+				return err
+			}
+			var var_9 string = /*line template.templ:26:8*/ title /*line template_templ.go:162:91*/
+			_, err = templBuffer.WriteString(templ.EscapeString(var_9))
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString(" ")
+			if err != nil {
+				return err
+			}
+			var_10 := `footer`
+			_, err = templBuffer.WriteString(var_10)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</p>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		var_11 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<ul>")
+			if err != nil {
+				return err
+			}
+			for /*line template.templ:21:7*/ _, item := range items /*line template_templ.go:195:93*/ {
+				_, err = templBuffer.WriteString("<li>")
+				if err != nil {
+					return err
+				}
+				var var_12 string = /*line template.templ:22:10*/ item /*line template_templ.go:200:93*/
+				_, err = templBuffer.WriteString(templ.EscapeString(var_12))
+				if err != nil {
+					return err
+				}
+				_, err = templBuffer.WriteString("</li>")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = templBuffer.WriteString("</ul>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = /*line template.templ:16:2*/ card(). /*line template_templ.go:219:78*/ Render(templ.WithSlot(templ.WithSlot(templ.WithChildren(ctx, var_11),
+			/*line template.templ:17:8*/ "header" /*line template_templ.go:220:75*/, var_6),
+			/*line template.templ:25:8*/ "footer" /*line template_templ.go:221:75*/, var_8), templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "page", FileName: "template.templ", Line: 16, Col: 3}
+		}
+		var_13 := templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+			templBuffer, templIsBuffer := w.(*bytes.Buffer)
+			if !templIsBuffer {
+				templBuffer = templ.GetBuffer()
+				defer templ.ReleaseBuffer(templBuffer)
+			}
+			_, err = templBuffer.WriteString("<p>")
+			if err != nil {
+				return err
+			}
+			var_14 := `No slots`
+			_, err = templBuffer.WriteString(var_14)
+			if err != nil {
+				return err
+			}
+			_, err = templBuffer.WriteString("</p>")
+			if err != nil {
+				return err
+			}
+			if !templIsBuffer {
+				_, err = io.Copy(w, templBuffer)
+			}
+			return err
+		})
+		err = /*line template.templ:29:2*/ card(). /*line template_templ.go:249:78*/ Render(templ.WithChildren(ctx, var_13), templBuffer)
+		if err != nil {
+			return &templ.Error{Err: err, Name: "page", FileName: "template.templ", Line: 29, Col: 3}
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
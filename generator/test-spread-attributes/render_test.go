@@ -0,0 +1,31 @@
+package testspreadattributes
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/a-h/templ/generator/htmldiff"
+)
+
+//go:embed expected.html
+var expected string
+
+func Test(t *testing.T) {
+	component := BasicTemplate(templ.Attributes{
+		"data-id":       "123",
+		"hx-get":        "/api/items?a=1&b=2",
+		"aria-hidden":   false,
+		"autofocus":     true,
+		"data-quoted":   `"quoted"`,
+		"data-tabindex": 1,
+	})
+
+	diff, err := htmldiff.Diff(component, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by templ@(devel) DO NOT EDIT.
+
+package testspreadattributes
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+func /*line template.templ:3:6*/ BasicTemplate(spread templ.Attributes) /*line template_templ.go:12:106*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "BasicTemplate", "testspreadattributes", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<div><a href=\"test\"")
+		if err != nil {
+			return err
+		}
+		err = templ.RenderAttributes(ctx, templBuffer /*line template.templ:5:19*/, spread /*line template_templ.go:34:119*/)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(">")
+		if err != nil {
+			return err
+		}
+		var_2 := `text`
+		_, err = templBuffer.WriteString(var_2)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</a><div")
+		if err != nil {
+			return err
+		}
+		if /*line template.templ:7:6*/ true /*line template_templ.go:51:71*/ {
+			err = templ.RenderAttributes(ctx, templBuffer /*line template.templ:8:6*/, spread /*line template_templ.go:52:119*/)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = templBuffer.WriteString(" id=\"conditional\"></div></div>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func render(input string) templ.Component {
+func /*line template.templ:3:6*/ render(input string) /*line template_templ.go:12:87*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,25 +17,34 @@ func render(input string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "render", "testswitch", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
 		}
 		ctx = templ.ClearChildren(ctx)
-		switch input {
-		case "a":
-			var var_2 string = "it was 'a'"
+		switch /*line template.templ:4:8*/ input /*line template_templ.go:30:76*/ {
+		/*line template.templ:5:2*/ case "a": /*line template_templ.go:31:73*/
+			var var_2 string = /*line template.templ:6:5*/ "it was 'a'" /*line template_templ.go:31:169*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_2))
 			if err != nil {
 				return err
 			}
-		default:
-			var var_3 string = "it was something else"
+			/*line template.templ:7:2*/
+		default: /*line template_templ.go:36:72*/
+			var var_3 string = /*line template.templ:8:5*/ "it was something else" /*line template_templ.go:36:179*/
 			_, err = templBuffer.WriteString(templ.EscapeString(var_3))
 			if err != nil {
 				return err
 			}
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -9,9 +9,10 @@ import "context"
 import "io"
 import "bytes"
 
-import "fmt"
+//line template.templ:3:1
+import "fmt" /*line template_templ.go:13:46*/
 
-func wrapper(index int) templ.Component {
+func /*line template.templ:5:6*/ wrapper(index int) /*line template_templ.go:15:85*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -19,6 +20,11 @@ func wrapper(index int) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "wrapper", "testtemplelement", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -28,7 +34,7 @@ func wrapper(index int) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(fmt.Sprint(index)))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:6:11*/ fmt.Sprint(index) /*line template_templ.go:37:135*/))
 		if err != nil {
 			return err
 		}
@@ -38,12 +44,15 @@ func wrapper(index int) templ.Component {
 		}
 		err = var_1.Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "wrapper", FileName: "template.templ"}
 		}
 		_, err = templBuffer.WriteString("</div>")
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -51,7 +60,7 @@ func wrapper(index int) templ.Component {
 	})
 }
 
-func template() templ.Component {
+func /*line template.templ:11:6*/ template() /*line template_templ.go:63:78*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -59,6 +68,11 @@ func template() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "template", "testtemplelement", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_2 := templ.GetChildren(ctx)
 		if var_2 == nil {
 			var_2 = templ.NopComponent
@@ -109,7 +123,7 @@ func template() templ.Component {
 					if err != nil {
 						return err
 					}
-					err = wrapper(4).Render(ctx, templBuffer)
+					err = /*line template.templ:18:5*/ wrapper(4). /*line template_templ.go:126:85*/ Render(ctx, templBuffer)
 					if err != nil {
 						return err
 					}
@@ -118,7 +132,7 @@ func template() templ.Component {
 					}
 					return err
 				})
-				err = wrapper(3).Render(templ.WithChildren(ctx, var_7), templBuffer)
+				err = /*line template.templ:16:4*/ wrapper(3). /*line template_templ.go:135:84*/ Render(templ.WithChildren(ctx, var_7), templBuffer)
 				if err != nil {
 					return err
 				}
@@ -127,7 +141,7 @@ func template() templ.Component {
 				}
 				return err
 			})
-			err = wrapper(2).Render(templ.WithChildren(ctx, var_5), templBuffer)
+			err = /*line template.templ:14:3*/ wrapper(2). /*line template_templ.go:144:83*/ Render(templ.WithChildren(ctx, var_5), templBuffer)
 			if err != nil {
 				return err
 			}
@@ -136,9 +150,12 @@ func template() templ.Component {
 			}
 			return err
 		})
-		err = wrapper(1).Render(templ.WithChildren(ctx, var_3), templBuffer)
+		err = /*line template.templ:12:2*/ wrapper(1). /*line template_templ.go:153:82*/ Render(templ.WithChildren(ctx, var_3), templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "template", FileName: "template.templ", Line: 12, Col: 3}
+		}
+		if templRender != nil {
+			templRender.Rendered()
 		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
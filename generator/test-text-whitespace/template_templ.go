@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func WhitespaceIsAddedWithinTemplStatements() templ.Component {
+func /*line template.templ:3:6*/ WhitespaceIsAddedWithinTemplStatements() /*line template_templ.go:12:108*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func WhitespaceIsAddedWithinTemplStatements() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "WhitespaceIsAddedWithinTemplStatements", "testtextwhitespace", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -35,7 +40,7 @@ func WhitespaceIsAddedWithinTemplStatements() templ.Component {
 		if err != nil {
 			return err
 		}
-		if true {
+		if /*line template.templ:6:9*/ true /*line template_templ.go:43:71*/ {
 			var_3 := `So is this.`
 			_, err = templBuffer.WriteString(var_3)
 			if err != nil {
@@ -46,6 +51,9 @@ func WhitespaceIsAddedWithinTemplStatements() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -53,9 +61,10 @@ func WhitespaceIsAddedWithinTemplStatements() templ.Component {
 	})
 }
 
-const WhitespaceIsAddedWithinTemplStatementsExpected = `<p>This is some text. So is this.</p>`
+//line template.templ:12:1
+const WhitespaceIsAddedWithinTemplStatementsExpected = `<p>This is some text. So is this.</p>` /*line template_templ.go:65:129*/
 
-func InlineElementsAreNotPadded() templ.Component {
+func /*line template.templ:14:6*/ InlineElementsAreNotPadded() /*line template_templ.go:67:96*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -63,6 +72,11 @@ func InlineElementsAreNotPadded() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "InlineElementsAreNotPadded", "testtextwhitespace", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_4 := templ.GetChildren(ctx)
 		if var_4 == nil {
 			var_4 = templ.NopComponent
@@ -99,6 +113,9 @@ func InlineElementsAreNotPadded() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -106,9 +123,10 @@ func InlineElementsAreNotPadded() templ.Component {
 	})
 }
 
-const InlineElementsAreNotPaddedExpected = `<p>Inline text <b>is spaced properly</b> without adding extra spaces.</p>`
+//line template.templ:18:1
+const InlineElementsAreNotPaddedExpected = `<p>Inline text <b>is spaced properly</b> without adding extra spaces.</p>` /*line template_templ.go:127:154*/
 
-func WhiteSpaceInHTMLIsNormalised() templ.Component {
+func /*line template.templ:20:6*/ WhiteSpaceInHTMLIsNormalised() /*line template_templ.go:129:99*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -116,6 +134,11 @@ func WhiteSpaceInHTMLIsNormalised() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "WhiteSpaceInHTMLIsNormalised", "testtextwhitespace", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_8 := templ.GetChildren(ctx)
 		if var_8 == nil {
 			var_8 = templ.NopComponent
@@ -152,6 +175,9 @@ func WhiteSpaceInHTMLIsNormalised() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -159,9 +185,10 @@ func WhiteSpaceInHTMLIsNormalised() templ.Component {
 	})
 }
 
-const WhiteSpaceInHTMLIsNormalisedExpected = `<p>newlines and other whitespace are stripped but it is normalised like HTML.</p>`
+//line template.templ:27:1
+const WhiteSpaceInHTMLIsNormalisedExpected = `<p>newlines and other whitespace are stripped but it is normalised like HTML.</p>` /*line template_templ.go:189:164*/
 
-func WhiteSpaceAroundValues() templ.Component {
+func /*line template.templ:29:6*/ WhiteSpaceAroundValues() /*line template_templ.go:191:93*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -169,6 +196,11 @@ func WhiteSpaceAroundValues() templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "WhiteSpaceAroundValues", "testtextwhitespace", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_12 := templ.GetChildren(ctx)
 		if var_12 == nil {
 			var_12 = templ.NopComponent
@@ -183,7 +215,7 @@ func WhiteSpaceAroundValues() templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_14 string = "strings"
+		var var_14 string = /*line template.templ:30:20*/ "strings" /*line template_templ.go:218:96*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_14))
 		if err != nil {
 			return err
@@ -201,6 +233,9 @@ func WhiteSpaceAroundValues() templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -208,4 +243,5 @@ func WhiteSpaceAroundValues() templ.Component {
 	})
 }
 
-const WhiteSpaceAroundValuesExpected = `<p>templ allows strings to be included in sentences.</p>`
+//line template.templ:33:1
+const WhiteSpaceAroundValuesExpected = `<p>templ allows strings to be included in sentences.</p>` /*line template_templ.go:247:133*/
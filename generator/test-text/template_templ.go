@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func BasicTemplate(name string) templ.Component {
+func /*line template.templ:3:6*/ BasicTemplate(name string) /*line template_templ.go:12:93*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func BasicTemplate(name string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "BasicTemplate", "testtext", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -31,7 +36,7 @@ func BasicTemplate(name string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_3 string = name
+		var var_3 string = /*line template.templ:4:15*/ name /*line template_templ.go:39:88*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_3))
 		if err != nil {
 			return err
@@ -63,7 +68,7 @@ func BasicTemplate(name string) templ.Component {
 		if err != nil {
 			return err
 		}
-		var var_7 string = name
+		var var_7 string = /*line template.templ:7:49*/ name /*line template_templ.go:71:88*/
 		_, err = templBuffer.WriteString(templ.EscapeString(var_7))
 		if err != nil {
 			return err
@@ -72,6 +77,9 @@ func BasicTemplate(name string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
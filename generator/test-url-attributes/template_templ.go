@@ -0,0 +1,188 @@
+// Code generated by templ@(devel) DO NOT EDIT.
+
+package testurlattributes
+
+//lint:file-ignore SA4006 This context is only used if a nested component is present.
+
+import "github.com/a-h/templ"
+import "context"
+import "io"
+import "bytes"
+
+func /*line template.templ:3:6*/ render(unsafe string, safe templ.SafeURL) /*line template_templ.go:12:109*/ templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		templBuffer, templIsBuffer := w.(*bytes.Buffer)
+		if !templIsBuffer {
+			templBuffer = templ.GetBuffer()
+			defer templ.ReleaseBuffer(templBuffer)
+		}
+		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "render", "testurlattributes", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
+		var_1 := templ.GetChildren(ctx)
+		if var_1 == nil {
+			var_1 = templ.NopComponent
+		}
+		ctx = templ.ClearChildren(ctx)
+		_, err = templBuffer.WriteString("<form action=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:4:16*/ unsafe /*line template_templ.go:34:158*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"><button formaction=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:5:23*/ unsafe /*line template_templ.go:42:158*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var_2 := `Submit`
+		_, err = templBuffer.WriteString(var_2)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</button><input type=\"image\" formaction=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:6:35*/ safe /*line template_templ.go:59:156*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"></form><iframe src=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:8:15*/ unsafe /*line template_templ.go:67:158*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"></iframe><img src=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:9:12*/ "/images/cat.png" /*line template_templ.go:75:169*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" srcset=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeSrcsetAttribute( /*line template.templ:9:41*/ "/images/cat-small.png 1x, " + unsafe + " 2x" /*line template_templ.go:83:200*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"><object data=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:10:16*/ unsafe /*line template_templ.go:91:159*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"></object><map name=\"map\"><area href=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:12:15*/ unsafe /*line template_templ.go:99:159*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" ping=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:12:31*/ unsafe /*line template_templ.go:107:160*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"></map><a href=\"/\" ping=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:14:20*/ unsafe /*line template_templ.go:115:160*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\">")
+		if err != nil {
+			return err
+		}
+		var_3 := `Home`
+		_, err = templBuffer.WriteString(var_3)
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("</a><link rel=\"stylesheet\" href=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:15:31*/ unsafe /*line template_templ.go:132:160*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"><link rel=\"preload\" as=\"image\" imagesrcset=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeSrcsetAttribute( /*line template.templ:16:46*/ "/images/cat-small.png 1x, " + unsafe + " 2x" /*line template_templ.go:140:202*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"><video poster=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:17:17*/ safe /*line template_templ.go:148:158*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"></video><blockquote cite=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString(string(templ.SanitizeURLAttribute( /*line template.templ:18:20*/ unsafe /*line template_templ.go:156:160*/))))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"></blockquote><div data=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:19:13*/ unsafe /*line template_templ.go:164:126*/))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\" title=\"")
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line template.templ:19:30*/ unsafe /*line template_templ.go:172:126*/))
+		if err != nil {
+			return err
+		}
+		_, err = templBuffer.WriteString("\"></div>")
+		if err != nil {
+			return err
+		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
+		if !templIsBuffer {
+			_, err = templBuffer.WriteTo(w)
+		}
+		return err
+	})
+}
@@ -18,6 +18,7 @@ require (
 	go.lsp.dev/uri v0.3.0
 	go.uber.org/zap v1.24.0
 	golang.org/x/mod v0.8.0
+	golang.org/x/net v0.9.0
 )
 
 require (
@@ -27,7 +28,6 @@ require (
 	go.lsp.dev/pkg v0.0.0-20210717090340-384b27a52fb2 // indirect
 	go.uber.org/atomic v1.10.0 // indirect
 	go.uber.org/multierr v1.9.0 // indirect
-	golang.org/x/net v0.9.0 // indirect
 	golang.org/x/sys v0.8.0 // indirect
 )
 
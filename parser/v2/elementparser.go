@@ -252,10 +252,53 @@ var expressionAttributeParser = parse.Func(func(pi *parse.Input) (attr Expressio
 	return attr, true, nil
 })
 
+// Spread attributes.
+var spreadAttributesSuffix = "..."
+
+var spreadAttributesParser = parse.Func(func(pi *parse.Input) (attr SpreadAttributes, ok bool, err error) {
+	start := pi.Index()
+
+	// Optional whitespace leader.
+	if _, ok, err = parse.OptionalWhitespace.Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// {
+	if _, ok, err = parse.Or(parse.String("{ "), parse.String("{")).Parse(pi); err != nil || !ok {
+		pi.Seek(start)
+		return
+	}
+
+	// Expression, including the trailing "...".
+	if attr.Expression, ok, err = exp.Parse(pi); err != nil || !ok {
+		pi.Seek(start)
+		return
+	}
+	if !strings.HasSuffix(attr.Expression.Value, spreadAttributesSuffix) {
+		// It's not a spread, so it's not an attribute that can be parsed.
+		pi.Seek(start)
+		return attr, false, nil
+	}
+	attr.Expression.Value = strings.TrimSuffix(attr.Expression.Value, spreadAttributesSuffix)
+	attr.Expression.Range.To.Index -= int64(len(spreadAttributesSuffix))
+	attr.Expression.Range.To.Col -= uint32(len(spreadAttributesSuffix))
+
+	// Eat the final brace.
+	if _, ok, err = Must(closeBraceWithOptionalPadding, "spread attributes: missing closing brace").Parse(pi); err != nil || !ok {
+		pi.Seek(start)
+		return
+	}
+
+	return attr, true, nil
+})
+
 // Attributes.
 type attributeParser struct{}
 
 func (attributeParser) Parse(in *parse.Input) (out Attribute, ok bool, err error) {
+	if out, ok, err = spreadAttributesParser.Parse(in); err != nil || ok {
+		return
+	}
 	if out, ok, err = boolExpressionAttributeParser.Parse(in); err != nil || ok {
 		return
 	}
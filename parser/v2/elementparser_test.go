@@ -300,6 +300,64 @@ if test {
 				},
 			},
 		},
+		{
+			name:   "spread attributes",
+			input:  `<div { spread... }>`,
+			parser: StripType(elementOpenTagParser),
+			expected: elementOpenTag{
+				Name: "div",
+				Attributes: []Attribute{
+					SpreadAttributes{
+						Expression: Expression{
+							Value: "spread",
+							Range: Range{
+								From: Position{
+									Index: 7,
+									Line:  0,
+									Col:   7,
+								},
+								To: Position{
+									Index: 13,
+									Line:  0,
+									Col:   13,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "spread attributes after constant attributes",
+			input:  `<a href="test" {attrs...}>`,
+			parser: StripType(elementOpenTagParser),
+			expected: elementOpenTag{
+				Name: "a",
+				Attributes: []Attribute{
+					ConstantAttribute{
+						Name:  "href",
+						Value: "test",
+					},
+					SpreadAttributes{
+						Expression: Expression{
+							Value: "attrs",
+							Range: Range{
+								From: Position{
+									Index: 16,
+									Line:  0,
+									Col:   16,
+								},
+								To: Position{
+									Index: 21,
+									Line:  0,
+									Col:   21,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:   "attribute containing escaped text",
 			input:  ` href="&lt;&quot;&gt;"`,
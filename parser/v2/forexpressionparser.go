@@ -31,6 +31,11 @@ var forExpression = parse.Func(func(pi *parse.Input) (r ForExpression, ok bool,
 		return
 	}
 
+	// Read the optional 'Else' Nodes, rendered if there are no iterations.
+	if r.Else, _, err = elseExpression.Parse(pi); err != nil {
+		return
+	}
+
 	// Read the required closing brace.
 	if _, ok, err = Must(closeBraceWithOptionalPadding, "for: missing end (expected '}')").Parse(pi); err != nil || !ok {
 		return
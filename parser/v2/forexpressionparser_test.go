@@ -115,6 +115,64 @@ func TestForExpressionParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "for: else",
+			input: `for _, item := range p.Items {
+	<li>{ item }</li>
+} else {
+	<p>No items</p>
+}`,
+			expected: ForExpression{
+				Expression: Expression{
+					Value: `_, item := range p.Items`,
+					Range: Range{
+						From: Position{
+							Index: 4,
+							Line:  0,
+							Col:   4,
+						},
+						To: Position{
+							Index: 28,
+							Line:  0,
+							Col:   28,
+						},
+					},
+				},
+				Children: []Node{
+					Whitespace{Value: "\t"},
+					Element{
+						Name: "li",
+						Children: []Node{
+							StringExpression{
+								Expression: Expression{
+									Value: `item`,
+									Range: Range{
+										From: Position{
+											Index: 38,
+											Line:  1,
+											Col:   7,
+										},
+										To: Position{
+											Index: 42,
+											Line:  1,
+											Col:   11,
+										},
+									},
+								},
+							},
+						},
+					},
+					Whitespace{Value: "\n"},
+				},
+				Else: []Node{
+					Element{
+						Name:     "p",
+						Children: []Node{Text{Value: "No items"}},
+					},
+					Whitespace{Value: "\n"},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -133,3 +191,18 @@ func TestForExpressionParser(t *testing.T) {
 		})
 	}
 }
+
+func TestForExpressionParserErrors(t *testing.T) {
+	input := parse.NewInput(`for _, item := range p.Items {
+	<li>{ item }</li>
+} else {
+	<p>No items</p>
+`)
+	_, _, err := forExpression.Parse(input)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if diff := cmp.Diff("else expression closing brace not found", err.Error()); diff != "" {
+		t.Error(diff)
+	}
+}
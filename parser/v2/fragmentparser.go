@@ -0,0 +1,38 @@
+package parser
+
+import (
+	"github.com/a-h/parse"
+)
+
+var fragmentExpression = parse.Func(func(pi *parse.Input) (r Fragment, ok bool, err error) {
+	// Check the prefix first.
+	if _, ok, err = parse.String("templ.Fragment(").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Once we've got a prefix, read the name until the closing bracket.
+	if r.Name, ok, err = Must[Expression](functionArgsParser{startBracketCount: 1}, "fragment: unterminated name (missing closing ')')").Parse(pi); err != nil || !ok {
+		return
+	}
+	if _, ok, err = Must(closeBracketWithOptionalPadding, "fragment: unterminated name (missing closing ')')").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Eat " {\n".
+	if _, ok, err = Must(parse.All(openBraceWithOptionalPadding, parse.NewLine), "fragment: unterminated (missing closing '{\n')").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Node contents.
+	tnp := newTemplateNodeParser(closeBraceWithOptionalPadding, "fragment closing brace")
+	if r.Children, ok, err = Must[[]Node](tnp, "fragment: expected nodes, but none were found").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Read the required closing brace.
+	if _, ok, err = Must(closeBraceWithOptionalPadding, "fragment: missing end (expected '}')").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	return r, true, nil
+})
@@ -0,0 +1,167 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/a-h/parse"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFragmentParser(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    string
+		expected interface{}
+	}{
+		{
+			name: "fragment: simple",
+			input: `templ.Fragment("content") {
+	<div>{ item }</div>
+}`,
+			expected: Fragment{
+				Name: Expression{
+					Value: `"content"`,
+					Range: Range{
+						From: Position{
+							Index: 15,
+							Line:  0,
+							Col:   15,
+						},
+						To: Position{
+							Index: 24,
+							Line:  0,
+							Col:   24,
+						},
+					},
+				},
+				Children: []Node{
+					Whitespace{Value: "\t"},
+					Element{
+						Name: "div",
+						Children: []Node{
+							StringExpression{
+								Expression: Expression{
+									Value: `item`,
+									Range: Range{
+										From: Position{
+											Index: 36,
+											Line:  1,
+											Col:   8,
+										},
+										To: Position{
+											Index: 40,
+											Line:  1,
+											Col:   12,
+										},
+									},
+								},
+							},
+						},
+					},
+					Whitespace{Value: "\n"},
+				},
+			},
+		},
+		{
+			name: "fragment: name expression, without spaces",
+			input: `templ.Fragment(names[0]){
+	<div>{ item }</div>
+}`,
+			expected: Fragment{
+				Name: Expression{
+					Value: `names[0]`,
+					Range: Range{
+						From: Position{
+							Index: 15,
+							Line:  0,
+							Col:   15,
+						},
+						To: Position{
+							Index: 23,
+							Line:  0,
+							Col:   23,
+						},
+					},
+				},
+				Children: []Node{
+					Whitespace{Value: "\t"},
+					Element{
+						Name: "div",
+						Children: []Node{
+							StringExpression{
+								Expression: Expression{
+									Value: `item`,
+									Range: Range{
+										From: Position{
+											Index: 34,
+											Line:  1,
+											Col:   8,
+										},
+										To: Position{
+											Index: 38,
+											Line:  1,
+											Col:   12,
+										},
+									},
+								},
+							},
+						},
+					},
+					Whitespace{Value: "\n"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			input := parse.NewInput(tt.input)
+			actual, ok, err := fragmentExpression.Parse(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !ok {
+				t.Fatalf("unexpected failure for input %q", tt.input)
+			}
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestFragmentParserErrors(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name: "fragment: unterminated name",
+			input: `templ.Fragment("content" {
+	<div></div>
+}`,
+			expected: "expression: unexpected bracket count: line 2, col 1",
+		},
+		{
+			name: "fragment: missing end",
+			input: `templ.Fragment("content") {
+	<div></div>
+`,
+			expected: "fragment closing brace not found",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			input := parse.NewInput(tt.input)
+			_, _, err := fragmentExpression.Parse(input)
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if diff := cmp.Diff(tt.expected, err.Error()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
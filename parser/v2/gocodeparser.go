@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/a-h/parse"
+)
+
+var goCodeStart = parse.String("{{")
+var goCodeEnd = parse.String("}}")
+
+// goCodeExpression parses Go statements within a template.
+// {{ total := sum(items) }}
+var goCodeExpression = parse.Func(func(pi *parse.Input) (r GoCode, ok bool, err error) {
+	// Check the prefix first.
+	if _, ok, err = goCodeStart.Parse(pi); err != nil || !ok {
+		return
+	}
+	if _, _, err = parse.OptionalWhitespace.Parse(pi); err != nil {
+		return
+	}
+
+	// Once we have a prefix, read the Go code until the closing braces.
+	if r.Expression, ok, err = Must[Expression](exp, "go code: unterminated (missing closing '}}')").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Remove trailing whitespace from the expression, so that its range ends with the code.
+	if trimmed := strings.TrimRight(r.Expression.Value, " \t\r\n"); trimmed != r.Expression.Value {
+		end := pi.Index()
+		pi.Seek(int(r.Expression.Range.From.Index) + len(trimmed))
+		to := pi.Position()
+		pi.Seek(end)
+		r.Expression.Value = trimmed
+		r.Expression.Range.To = NewPosition(int64(to.Index), uint32(to.Line), uint32(to.Col))
+	}
+
+	// }}
+	if _, _, err = parse.OptionalWhitespace.Parse(pi); err != nil {
+		return
+	}
+	if _, ok, err = Must(goCodeEnd, "go code: missing close braces (expected '}}')").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Eat any trailing whitespace, since Go code doesn't produce any output.
+	if _, _, err = parse.OptionalWhitespace.Parse(pi); err != nil {
+		return
+	}
+
+	return r, true, nil
+})
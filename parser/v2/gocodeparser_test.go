@@ -0,0 +1,136 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/a-h/parse"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGoCodeParser(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    string
+		expected GoCode
+	}{
+		{
+			name:  "go code: single statement",
+			input: `{{ total := sum(items) }}`,
+			expected: GoCode{
+				Expression: Expression{
+					Value: `total := sum(items)`,
+					Range: Range{
+						From: Position{
+							Index: 3,
+							Line:  0,
+							Col:   3,
+						},
+						To: Position{
+							Index: 22,
+							Line:  0,
+							Col:   22,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "go code: without spaces, containing braces",
+			input: `{{seen := map[string]struct{}{}}}`,
+			expected: GoCode{
+				Expression: Expression{
+					Value: `seen := map[string]struct{}{}`,
+					Range: Range{
+						From: Position{
+							Index: 2,
+							Line:  0,
+							Col:   2,
+						},
+						To: Position{
+							Index: 31,
+							Line:  0,
+							Col:   31,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "go code: multiple lines",
+			input: `{{
+	total := 0
+	for _, item := range items {
+		total += item.Price
+	}
+}}`,
+			expected: GoCode{
+				Expression: Expression{
+					Value: "total := 0\n\tfor _, item := range items {\n\t\ttotal += item.Price\n\t}",
+					Range: Range{
+						From: Position{
+							Index: 4,
+							Line:  1,
+							Col:   1,
+						},
+						To: Position{
+							Index: 69,
+							Line:  4,
+							Col:   2,
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			input := parse.NewInput(tt.input)
+			actual, ok, err := goCodeExpression.Parse(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !ok {
+				t.Fatalf("unexpected failure for input %q", tt.input)
+			}
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Error(diff)
+			}
+			if input.Index() != len(tt.input) {
+				t.Errorf("expected all of the input to be read, but stopped at index %d", input.Index())
+			}
+		})
+	}
+}
+
+func TestGoCodeParserErrors(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "go code: unterminated",
+			input:    `{{ total := sum(items)`,
+			expected: "expression: unexpected brace count: line 0, col 22",
+		},
+		{
+			name:     "go code: single closing brace",
+			input:    `{{ total := sum(items) }<div></div>`,
+			expected: "go code: missing close braces (expected '}}'): line 0, col 23",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			input := parse.NewInput(tt.input)
+			_, _, err := goCodeExpression.Parse(input)
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if diff := cmp.Diff(tt.expected, err.Error()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package parser
+
+import (
+	"github.com/a-h/parse"
+)
+
+var htmlCommentStart = parse.String("<!--")
+var htmlCommentEnd = parse.String("-->")
+
+var htmlCommentParser = parse.Func(func(pi *parse.Input) (c HTMLComment, ok bool, err error) {
+	// Check the prefix first.
+	if _, ok, err = htmlCommentStart.Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Once we've got the comment start sequence, parse anything until the end
+	// sequence as the comment contents.
+	if c.Contents, ok, err = Must(parse.StringUntil(htmlCommentEnd), "expected end comment sequence not present").Parse(pi); err != nil || !ok {
+		return
+	}
+	// Cut off the end sequence.
+	if _, ok, err = htmlCommentEnd.Parse(pi); err != nil || !ok {
+		return
+	}
+
+	return c, true, nil
+})
@@ -0,0 +1,38 @@
+package parser
+
+import (
+	"github.com/a-h/parse"
+)
+
+var onceExpression = parse.Func(func(pi *parse.Input) (r Once, ok bool, err error) {
+	// Check the prefix first.
+	if _, ok, err = parse.String("templ.Once(").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Once we've got a prefix, read the key until the closing bracket.
+	if r.Key, ok, err = Must[Expression](functionArgsParser{startBracketCount: 1}, "once: unterminated key (missing closing ')')").Parse(pi); err != nil || !ok {
+		return
+	}
+	if _, ok, err = Must(closeBracketWithOptionalPadding, "once: unterminated key (missing closing ')')").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Eat " {\n".
+	if _, ok, err = Must(parse.All(openBraceWithOptionalPadding, parse.NewLine), "once: unterminated (missing closing '{\n')").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Node contents.
+	tnp := newTemplateNodeParser(closeBraceWithOptionalPadding, "once closing brace")
+	if r.Children, ok, err = Must[[]Node](tnp, "once: expected nodes, but none were found").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Read the required closing brace.
+	if _, ok, err = Must(closeBraceWithOptionalPadding, "once: missing end (expected '}')").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	return r, true, nil
+})
@@ -0,0 +1,132 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/a-h/parse"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOnceParser(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    string
+		expected interface{}
+	}{
+		{
+			name: "once: simple",
+			input: `templ.Once("chart") {
+	<script src="chart.js"></script>
+}`,
+			expected: Once{
+				Key: Expression{
+					Value: `"chart"`,
+					Range: Range{
+						From: Position{
+							Index: 11,
+							Line:  0,
+							Col:   11,
+						},
+						To: Position{
+							Index: 18,
+							Line:  0,
+							Col:   18,
+						},
+					},
+				},
+				Children: []Node{
+					Whitespace{Value: "\t"},
+					RawElement{
+						Name: "script",
+						Attributes: []Attribute{
+							ConstantAttribute{Name: "src", Value: "chart.js"},
+						},
+					},
+					Whitespace{Value: "\n"},
+				},
+			},
+		},
+		{
+			name: "once: key expression, without spaces",
+			input: `templ.Once(keys.Chart){
+	<div></div>
+}`,
+			expected: Once{
+				Key: Expression{
+					Value: `keys.Chart`,
+					Range: Range{
+						From: Position{
+							Index: 11,
+							Line:  0,
+							Col:   11,
+						},
+						To: Position{
+							Index: 21,
+							Line:  0,
+							Col:   21,
+						},
+					},
+				},
+				Children: []Node{
+					Whitespace{Value: "\t"},
+					Element{
+						Name: "div",
+					},
+					Whitespace{Value: "\n"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			input := parse.NewInput(tt.input)
+			actual, ok, err := onceExpression.Parse(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !ok {
+				t.Fatalf("unexpected failure for input %q", tt.input)
+			}
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestOnceParserErrors(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name: "once: unterminated key",
+			input: `templ.Once("chart" {
+	<div></div>
+}`,
+			expected: "expression: unexpected bracket count: line 2, col 1",
+		},
+		{
+			name: "once: missing end",
+			input: `templ.Once("chart") {
+	<div></div>
+`,
+			expected: "once closing brace not found",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			input := parse.NewInput(tt.input)
+			_, _, err := onceExpression.Parse(input)
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if diff := cmp.Diff(tt.expected, err.Error()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
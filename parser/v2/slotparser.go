@@ -0,0 +1,79 @@
+package parser
+
+import (
+	"github.com/a-h/parse"
+)
+
+// slotExpression parses the definition of a named slot within the children of a templ element.
+// @slot("header") { ... }
+var slotExpression = parse.Func(func(pi *parse.Input) (r Slot, ok bool, err error) {
+	start := pi.Index()
+
+	// Check the prefix first.
+	if _, ok, err = parse.String("@slot(").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Once we've got a prefix, read the name until the closing bracket.
+	if r.Name, ok, err = Must[Expression](functionArgsParser{startBracketCount: 1}, "slot: unterminated name (missing closing ')')").Parse(pi); err != nil || !ok {
+		return
+	}
+	if _, ok, err = Must(closeBracketWithOptionalPadding, "slot: unterminated name (missing closing ')')").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Without a block, it's a call to a component named slot.
+	if _, ok, err = openBraceWithOptionalPadding.Parse(pi); err != nil || !ok {
+		pi.Seek(start)
+		return
+	}
+
+	// Node contents.
+	tnp := newTemplateNodeParser(closeBraceWithOptionalPadding, "slot closing brace")
+	if r.Children, ok, err = Must[[]Node](tnp, "slot: expected nodes, but none were found").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Read the required closing brace.
+	if _, ok, err = Must(closeBraceWithOptionalPadding, "slot: missing end (expected '}')").Parse(pi); err != nil || !ok {
+		return
+	}
+
+	return r, true, nil
+})
+
+// slotRenderExpression parses an expression that renders a named slot.
+// { slot("header")... }
+// { slot("header", fallback)... }
+var slotRenderExpression = parse.Func(func(pi *parse.Input) (r SlotExpression, ok bool, err error) {
+	start := pi.Index()
+
+	// Check the prefix first.
+	if _, ok, err = parse.StringFrom(
+		openBraceWithOptionalPadding,
+		parse.OptionalWhitespace,
+		parse.String("slot("),
+	).Parse(pi); err != nil || !ok {
+		return
+	}
+
+	// Read the arguments until the closing bracket.
+	// If they can't be read, leave the string expression parser to report the error.
+	if r.Expression, ok, err = (functionArgsParser{startBracketCount: 1}).Parse(pi); err != nil || !ok {
+		pi.Seek(start)
+		return r, false, nil
+	}
+
+	// Without the trailing "...", it's a string expression that calls a function named slot.
+	if _, ok, err = parse.StringFrom(
+		closeBracketWithOptionalPadding,
+		parse.String("..."),
+		parse.OptionalWhitespace,
+		closeBraceWithOptionalPadding,
+	).Parse(pi); err != nil || !ok {
+		pi.Seek(start)
+		return
+	}
+
+	return r, true, nil
+})
@@ -0,0 +1,227 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/a-h/parse"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSlotParser(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    string
+		expected interface{}
+	}{
+		{
+			name: "slot: simple",
+			input: `@slot("header") {
+	<h1>Title</h1>
+}`,
+			expected: Slot{
+				Name: Expression{
+					Value: `"header"`,
+					Range: Range{
+						From: Position{
+							Index: 6,
+							Line:  0,
+							Col:   6,
+						},
+						To: Position{
+							Index: 14,
+							Line:  0,
+							Col:   14,
+						},
+					},
+				},
+				Children: []Node{
+					Whitespace{Value: "\n\t"},
+					Element{
+						Name:     "h1",
+						Children: []Node{Text{Value: "Title"}},
+					},
+					Whitespace{Value: "\n"},
+				},
+			},
+		},
+		{
+			name: "slot: name expression, without spaces",
+			input: `@slot(slots.Footer){
+	<div></div>
+}`,
+			expected: Slot{
+				Name: Expression{
+					Value: `slots.Footer`,
+					Range: Range{
+						From: Position{
+							Index: 6,
+							Line:  0,
+							Col:   6,
+						},
+						To: Position{
+							Index: 18,
+							Line:  0,
+							Col:   18,
+						},
+					},
+				},
+				Children: []Node{
+					Whitespace{Value: "\n\t"},
+					Element{
+						Name: "div",
+					},
+					Whitespace{Value: "\n"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			input := parse.NewInput(tt.input)
+			actual, ok, err := slotExpression.Parse(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !ok {
+				t.Fatalf("unexpected failure for input %q", tt.input)
+			}
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSlotParserErrors(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name: "slot: unterminated name",
+			input: `@slot("header" {
+	<div></div>
+}`,
+			expected: "expression: unexpected bracket count: line 2, col 1",
+		},
+		{
+			name: "slot: missing end",
+			input: `@slot("header") {
+	<div></div>
+`,
+			expected: "slot closing brace not found",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			input := parse.NewInput(tt.input)
+			_, _, err := slotExpression.Parse(input)
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if diff := cmp.Diff(tt.expected, err.Error()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSlotParserIgnoresComponentsNamedSlot(t *testing.T) {
+	input := parse.NewInput(`@slot("header")`)
+	_, ok, err := slotExpression.Parse(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok {
+		t.Fatal("expected a call without a block not to be parsed as a slot")
+	}
+	if input.Index() != 0 {
+		t.Errorf("expected the input not to be consumed, but the index is %d", input.Index())
+	}
+}
+
+func TestSlotExpressionParser(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    string
+		expected SlotExpression
+	}{
+		{
+			name:  "slot expression: name",
+			input: `{ slot("header")... }`,
+			expected: SlotExpression{
+				Expression: Expression{
+					Value: `"header"`,
+					Range: Range{
+						From: Position{
+							Index: 7,
+							Line:  0,
+							Col:   7,
+						},
+						To: Position{
+							Index: 15,
+							Line:  0,
+							Col:   15,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "slot expression: name and fallback, without spaces",
+			input: `{slot("header", defaultHeader(title))...}`,
+			expected: SlotExpression{
+				Expression: Expression{
+					Value: `"header", defaultHeader(title)`,
+					Range: Range{
+						From: Position{
+							Index: 6,
+							Line:  0,
+							Col:   6,
+						},
+						To: Position{
+							Index: 36,
+							Line:  0,
+							Col:   36,
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			input := parse.NewInput(tt.input)
+			actual, ok, err := slotRenderExpression.Parse(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !ok {
+				t.Fatalf("unexpected failure for input %q", tt.input)
+			}
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestSlotExpressionParserIgnoresStringExpressions(t *testing.T) {
+	for _, input := range []string{`{ slot("header") }`, `{ slots["header"] }`, `{ slot("header" }`} {
+		pi := parse.NewInput(input)
+		_, ok, err := slotRenderExpression.Parse(pi)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", input, err)
+		}
+		if ok {
+			t.Errorf("%q: expected not to be parsed as a slot expression", input)
+		}
+		if pi.Index() != 0 {
+			t.Errorf("%q: expected the input not to be consumed, but the index is %d", input, pi.Index())
+		}
+	}
+}
@@ -76,6 +76,18 @@ func (p templateNodeParser[T]) Parse(pi *parse.Input) (op []Node, ok bool, err e
 		}
 
 		// Try for valid nodes.
+		// Try for an HTML comment.
+		// <!-- comment -->
+		var commentNode HTMLComment
+		commentNode, ok, err = htmlCommentParser.Parse(pi)
+		if err != nil {
+			return
+		}
+		if ok {
+			op = append(op, commentNode)
+			continue
+		}
+
 		// Try for a doctype.
 		// <!DOCTYPE html>
 		var docTypeNode DocType
@@ -147,6 +159,30 @@ func (p templateNodeParser[T]) Parse(pi *parse.Input) (op []Node, ok bool, err e
 			continue
 		}
 
+		// Try for a fragment.
+		// templ.Fragment("name") {}
+		var fragmentNode Fragment
+		fragmentNode, ok, err = fragmentExpression.Parse(pi)
+		if err != nil {
+			return
+		}
+		if ok {
+			op = append(op, fragmentNode)
+			continue
+		}
+
+		// Try for a once block.
+		// templ.Once("key") {}
+		var onceNode Once
+		onceNode, ok, err = onceExpression.Parse(pi)
+		if err != nil {
+			return
+		}
+		if ok {
+			op = append(op, onceNode)
+			continue
+		}
+
 		// Try for a call template expression.
 		// {! TemplateName(a, b, c) }
 		var cteNode CallTemplateExpression
@@ -159,6 +195,18 @@ func (p templateNodeParser[T]) Parse(pi *parse.Input) (op []Node, ok bool, err e
 			continue
 		}
 
+		// Try for a slot.
+		// @slot("name") {}
+		var slotNode Slot
+		slotNode, ok, err = slotExpression.Parse(pi)
+		if err != nil {
+			return
+		}
+		if ok {
+			op = append(op, slotNode)
+			continue
+		}
+
 		// Try for a templ element expression.
 		// <!TemplateName(a, b, c) />
 		var templElementNode TemplElementExpression
@@ -171,6 +219,30 @@ func (p templateNodeParser[T]) Parse(pi *parse.Input) (op []Node, ok bool, err e
 			continue
 		}
 
+		// Try for Go code.
+		// {{ total := sum(items) }}
+		var goCodeNode GoCode
+		goCodeNode, ok, err = goCodeExpression.Parse(pi)
+		if err != nil {
+			return
+		}
+		if ok {
+			op = append(op, goCodeNode)
+			continue
+		}
+
+		// Try for a slot expression.
+		// { slot("name")... }
+		var slotExpressionNode SlotExpression
+		slotExpressionNode, ok, err = slotRenderExpression.Parse(pi)
+		if err != nil {
+			return
+		}
+		if ok {
+			op = append(op, slotExpressionNode)
+			continue
+		}
+
 		// Try for a children element expression.
 		// { children... }
 		var childrenExpressionNode ChildrenExpression
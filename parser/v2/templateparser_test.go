@@ -58,6 +58,72 @@ func TestTemplateParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "template: with type parameters",
+			input: `templ Table[T any](rows []T, cols []Column[T]) {
+}`,
+			expected: HTMLTemplate{
+				Expression: Expression{
+					Value: "Table[T any](rows []T, cols []Column[T])",
+					Range: Range{
+						From: Position{
+							Index: 6,
+							Line:  0,
+							Col:   6,
+						},
+						To: Position{
+							Index: 46,
+							Line:  0,
+							Col:   46,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "template: with type constraints containing braces",
+			input: `templ Sum[T interface{ ~int | ~float64 }](values []T) {
+}`,
+			expected: HTMLTemplate{
+				Expression: Expression{
+					Value: "Sum[T interface{ ~int | ~float64 }](values []T)",
+					Range: Range{
+						From: Position{
+							Index: 6,
+							Line:  0,
+							Col:   6,
+						},
+						To: Position{
+							Index: 53,
+							Line:  0,
+							Col:   53,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "template: with generic receiver",
+			input: `templ (l *List[K, V]) Render(title string) {
+}`,
+			expected: HTMLTemplate{
+				Expression: Expression{
+					Value: "(l *List[K, V]) Render(title string)",
+					Range: Range{
+						From: Position{
+							Index: 6,
+							Line:  0,
+							Col:   6,
+						},
+						To: Position{
+							Index: 42,
+							Line:  0,
+							Col:   42,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "template: no spaces",
 			input: `templ Name(){
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"fmt"
+	"strings"
 	"unicode"
 
 	"github.com/a-h/parse"
@@ -15,12 +16,44 @@ var templElementStartExpressionParams = parse.StringFrom(
 	parse.String(")"),
 )
 
+// templElementStartExpressionTypeArgs parses the type arguments of a generic template, e.g.
+// [User] in @Table[User](users, cols).
+var templElementStartExpressionTypeArgs = parse.Func(func(pi *parse.Input) (s string, ok bool, err error) {
+	start := pi.Index()
+	if _, ok, err = parse.String("[").Parse(pi); err != nil || !ok {
+		return
+	}
+	bracketCount := 1
+	var sb strings.Builder
+	sb.WriteString("[")
+	for bracketCount > 0 {
+		var c string
+		if c, ok = pi.Take(1); !ok {
+			// No closing bracket, so it's not a type argument list.
+			pi.Seek(start)
+			return "", false, nil
+		}
+		switch c {
+		case "[":
+			bracketCount++
+		case "]":
+			bracketCount--
+		case "\n":
+			pi.Seek(start)
+			return "", false, nil
+		}
+		sb.WriteString(c)
+	}
+	return sb.String(), true, nil
+})
+
 var templElementStartExpression = ExpressionOf(parse.StringFrom(
 	parse.AtLeast(1, parse.StringFrom(
 		parse.StringFrom(parse.Optional(parse.String("."))),
 		parse.StringFrom(parse.Optional(parse.String("_"))),
 		parse.RuneInRanges(unicode.Letter),
 		parse.StringFrom(parse.AtMost(255, parse.RuneInRanges(unicode.Letter, unicode.Number))),
+		parse.StringFrom(parse.Optional(templElementStartExpressionTypeArgs)),
 		parse.StringFrom(parse.Optional(templElementStartExpressionParams)),
 	)),
 ))
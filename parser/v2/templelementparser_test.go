@@ -213,6 +213,69 @@ func TestTemplElementExpressionParser(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "templelement: supports type arguments",
+			input: `@Table[User](users, cols)`,
+			expected: TemplElementExpression{
+				Expression: Expression{
+					Value: `Table[User](users, cols)`,
+					Range: Range{
+						From: Position{
+							Index: 1,
+							Line:  0,
+							Col:   1,
+						},
+						To: Position{
+							Index: 25,
+							Line:  0,
+							Col:   25,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "templelement: supports nested type arguments in other packages",
+			input: `@components.Table[Pair[string, int]](rows)`,
+			expected: TemplElementExpression{
+				Expression: Expression{
+					Value: `components.Table[Pair[string, int]](rows)`,
+					Range: Range{
+						From: Position{
+							Index: 1,
+							Line:  0,
+							Col:   1,
+						},
+						To: Position{
+							Index: 42,
+							Line:  0,
+							Col:   42,
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "templelement: unterminated type arguments are left as text",
+			input: `@Table[User`,
+			expected: TemplElementExpression{
+				Expression: Expression{
+					Value: `Table`,
+					Range: Range{
+						From: Position{
+							Index: 1,
+							Line:  0,
+							Col:   1,
+						},
+						To: Position{
+							Index: 6,
+							Line:  0,
+							Col:   6,
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
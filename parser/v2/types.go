@@ -270,6 +270,18 @@ func (dt DocType) Write(w io.Writer, indent int) error {
 	return writeIndent(w, indent, "<!DOCTYPE "+dt.Value+">")
 }
 
+// HTMLComment is an HTML comment, which is written to the output unless the generator is
+// configured to strip comments.
+// <!-- ... -->
+type HTMLComment struct {
+	Contents string
+}
+
+func (c HTMLComment) IsNode() bool { return true }
+func (c HTMLComment) Write(w io.Writer, indent int) error {
+	return writeIndent(w, indent, "<!--"+c.Contents+"-->")
+}
+
 // HTMLTemplate definition.
 //
 //	templ Name(p Parameter) {
@@ -364,6 +376,10 @@ func (e Element) containsBlockElement() bool {
 			continue
 		case Text:
 			continue
+		case HTMLComment:
+			continue
+		case SlotExpression:
+			continue
 		case TemplElementExpression:
 			if len(n.Children) > 0 {
 				return true
@@ -604,6 +620,20 @@ func (ea ExpressionAttribute) Write(w io.Writer, indent int) error {
 	return writeIndent(w, indent, ea.String())
 }
 
+// <a { spread... }/>
+type SpreadAttributes struct {
+	Expression Expression
+}
+
+func (sa SpreadAttributes) IsMultilineAttr() bool { return false }
+func (sa SpreadAttributes) String() string {
+	return `{ ` + sa.Expression.Value + `... }`
+}
+
+func (sa SpreadAttributes) Write(w io.Writer, indent int) error {
+	return writeIndent(w, indent, sa.String())
+}
+
 //	<a href="test" \
 //		if active {
 //	   class="isActive"
@@ -733,6 +763,96 @@ func (ChildrenExpression) Write(w io.Writer, indent int) error {
 	return nil
 }
 
+// Slot is a named block of content passed to a templ element. It's rendered by the
+// SlotExpression with the same name.
+// @slot("header") { ... }
+type Slot struct {
+	Name     Expression
+	Children []Node
+}
+
+func (s Slot) IsNode() bool { return true }
+func (s Slot) Write(w io.Writer, indent int) error {
+	if err := writeIndent(w, indent, "@slot("+s.Name.Value+") {\n"); err != nil {
+		return err
+	}
+	if err := writeNodesBlock(w, indent+1, s.Children); err != nil {
+		return err
+	}
+	if err := writeIndent(w, indent, "}"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SlotExpression renders a named slot passed to the template, or the optional fallback
+// component if the slot wasn't passed.
+// { slot("header")... }
+// { slot("header", fallback)... }
+type SlotExpression struct {
+	// Expression is the arguments, i.e. the name of the slot, and the optional fallback.
+	Expression Expression
+}
+
+func (se SlotExpression) IsNode() bool { return true }
+func (se SlotExpression) Write(w io.Writer, indent int) error {
+	return writeIndent(w, indent, "{ slot("+se.Expression.Value+")... }")
+}
+
+// GoCode is Go code within a template, such as a variable declaration.
+// {{ total := sum(items) }}
+type GoCode struct {
+	Expression Expression
+}
+
+func (gc GoCode) IsNode() bool { return true }
+func (gc GoCode) Write(w io.Writer, indent int) error {
+	if !strings.Contains(gc.Expression.Value, "\n") {
+		return writeIndent(w, indent, "{{ "+gc.Expression.Value+" }}")
+	}
+	// Multiple lines are written within the braces, keeping their indentation relative to
+	// each other.
+	if err := writeIndent(w, indent, "{{\n"); err != nil {
+		return err
+	}
+	lines := strings.Split(gc.Expression.Value, "\n")
+	prefix := commonIndent(lines[1:])
+	for i, line := range lines {
+		if i > 0 {
+			line = strings.TrimPrefix(line, prefix)
+		}
+		if strings.TrimSpace(line) == "" {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := writeIndent(w, indent+1, line+"\n"); err != nil {
+			return err
+		}
+	}
+	return writeIndent(w, indent, "}}")
+}
+
+// commonIndent returns the leading whitespace shared by all of the non-blank lines.
+func commonIndent(lines []string) (prefix string) {
+	first := true
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if first {
+			prefix, first = indent, false
+			continue
+		}
+		for !strings.HasPrefix(indent, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
 // if p.Type == "test" && p.thing {
 // }
 type IfExpression struct {
@@ -823,6 +943,8 @@ type CaseExpression struct {
 type ForExpression struct {
 	Expression Expression
 	Children   []Node
+	// Else is rendered if the loop has no iterations.
+	Else []Node
 }
 
 func (fe ForExpression) IsNode() bool { return true }
@@ -833,6 +955,58 @@ func (fe ForExpression) Write(w io.Writer, indent int) error {
 	if err := writeNodesBlock(w, indent+1, fe.Children); err != nil {
 		return err
 	}
+	if len(fe.Else) > 0 {
+		if err := writeIndent(w, indent, "} else {\n"); err != nil {
+			return err
+		}
+		if err := writeNodesBlock(w, indent+1, fe.Else); err != nil {
+			return err
+		}
+	}
+	if err := writeIndent(w, indent, "}"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Fragment is a named block of a template, which can be rendered on its own with
+// templ.RenderFragments.
+// templ.Fragment("name") { ... }
+type Fragment struct {
+	Name     Expression
+	Children []Node
+}
+
+func (f Fragment) IsNode() bool { return true }
+func (f Fragment) Write(w io.Writer, indent int) error {
+	if err := writeIndent(w, indent, "templ.Fragment("+f.Name.Value+") {\n"); err != nil {
+		return err
+	}
+	if err := writeNodesBlock(w, indent+1, f.Children); err != nil {
+		return err
+	}
+	if err := writeIndent(w, indent, "}"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Once is a block of a template that's only rendered the first time that a Once block with
+// the same key is rendered.
+// templ.Once("key") { ... }
+type Once struct {
+	Key      Expression
+	Children []Node
+}
+
+func (o Once) IsNode() bool { return true }
+func (o Once) Write(w io.Writer, indent int) error {
+	if err := writeIndent(w, indent, "templ.Once("+o.Key.Value+") {\n"); err != nil {
+		return err
+	}
+	if err := writeNodesBlock(w, indent+1, o.Children); err != nil {
+		return err
+	}
 	if err := writeIndent(w, indent, "}"); err != nil {
 		return err
 	}
@@ -417,6 +417,240 @@ templ conditionalAttributes(addClass bool) {
 		width="300">Content</div>
 }
 
+`,
+		},
+		{
+			name: "spread attributes are formatted with padding",
+			input: ` // first line removed to make indentation clear
+package test
+
+templ spread(attrs templ.Attributes) {
+	<div id="spread" {attrs...}>Content</div>
+}
+`,
+			expected: ` // first line removed to make indentation clear
+package test
+
+templ spread(attrs templ.Attributes) {
+	<div id="spread" { attrs... }>Content</div>
+}
+
+`,
+		},
+		{
+			name: "fragments are placed on a new line",
+			input: ` // first line removed to make indentation clear
+package test
+
+templ page(items []string) {
+<div>{ "the" }templ.Fragment("items") {
+<ul>
+for _, item := range items {
+<li>{ item }</li>
+}
+</ul>
+}</div>
+}
+`,
+			expected: ` // first line removed to make indentation clear
+package test
+
+templ page(items []string) {
+	<div>
+		{ "the" }
+		templ.Fragment("items") {
+			<ul>
+				for _, item := range items {
+					<li>{ item }</li>
+				}
+			</ul>
+		}
+	</div>
+}
+
+`,
+		},
+		{
+			name: "HTML comments are preserved",
+			input: ` // first line removed to make indentation clear
+package test
+
+templ page() {
+<!-- Licence: MIT -->
+<div><!-- ko foreach: items --><span data-bind="text: name"></span><!-- /ko --></div>
+<ul>
+<!--
+	Multi-line
+	comment
+-->
+<li>Item</li>
+</ul>
+}
+`,
+			expected: ` // first line removed to make indentation clear
+package test
+
+templ page() {
+	<!-- Licence: MIT -->
+	<div><!-- ko foreach: items --><span data-bind="text: name"></span><!-- /ko --></div>
+	<ul>
+		<!--
+	Multi-line
+	comment
+-->
+		<li>Item</li>
+	</ul>
+}
+
+`,
+		},
+		{
+			name: "once blocks are formatted like other blocks",
+			input: ` // first line removed to make indentation clear
+package test
+
+templ item(name string) {
+<li>templ.Once("chart") {
+<script src="chart.js"></script>
+}{ name }</li>
+}
+`,
+			expected: ` // first line removed to make indentation clear
+package test
+
+templ item(name string) {
+	<li>
+		templ.Once("chart") {
+			<script src="chart.js"></script>
+		}
+		{ name }
+	</li>
+}
+
+`,
+		},
+		{
+			name: "slots and slot expressions are formatted",
+			input: ` // first line removed to make indentation clear
+package test
+
+templ card() {
+<div class="card"><div class="header">{slot("header", defaultHeader())...}</div>{ children... }</div>
+}
+
+templ page() {
+@card() {
+@slot("header") {
+<h1>Title</h1>
+}
+<p>Body</p>
+}
+}
+`,
+			expected: ` // first line removed to make indentation clear
+package test
+
+templ card() {
+	<div class="card">
+		<div class="header">{ slot("header", defaultHeader())... }</div>
+		{ children... }
+	</div>
+}
+
+templ page() {
+	@card() {
+		@slot("header") {
+			<h1>Title</h1>
+		}
+		<p>Body</p>
+	}
+}
+
+`,
+		},
+		{
+			name: "go code is formatted on its own line",
+			input: ` // first line removed to make indentation clear
+package test
+
+templ basket(items []Item) {
+<div>{{total := sum(items)}}<span>{ total }</span></div>
+}
+`,
+			expected: ` // first line removed to make indentation clear
+package test
+
+templ basket(items []Item) {
+	<div>
+		{{ total := sum(items) }}
+		<span>{ total }</span>
+	</div>
+}
+
+`,
+		},
+		{
+			name: "for expressions with else blocks are formatted",
+			input: ` // first line removed to make indentation clear
+package test
+
+templ list(items []string) {
+<ul>
+for _, item := range items {
+<li>{ item }</li>
+} else {
+<li>No items</li>
+}
+</ul>
+}
+`,
+			expected: ` // first line removed to make indentation clear
+package test
+
+templ list(items []string) {
+	<ul>
+		for _, item := range items {
+			<li>{ item }</li>
+		} else {
+			<li>No items</li>
+		}
+	</ul>
+}
+
+`,
+		},
+		{
+			name: "go code spanning multiple lines is indented",
+			input: ` // first line removed to make indentation clear
+package test
+
+templ basket(items []Item) {
+<div>
+{{
+total := 0
+for _, item := range items {
+	total += item.Price
+}
+}}
+<span>{ total }</span>
+</div>
+}
+`,
+			expected: ` // first line removed to make indentation clear
+package test
+
+templ basket(items []Item) {
+	<div>
+		{{
+			total := 0
+			for _, item := range items {
+				total += item.Price
+			}
+		}}
+		<span>{ total }</span>
+	</div>
+}
+
 `,
 		},
 		{
@@ -2,18 +2,25 @@ package templ
 
 import (
 	"bytes"
+	"compress/gzip"
+	"container/list"
 	"context"
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"html"
 	"io"
 	"net/http"
+	"path"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/a-h/templ/safehtml"
 )
@@ -44,6 +51,7 @@ func WithChildren(ctx context.Context, children Component) context.Context {
 func ClearChildren(ctx context.Context) context.Context {
 	_, v := getContext(ctx)
 	v.children = nil
+	v.slots = nil
 	return ctx
 }
 
@@ -59,30 +67,1059 @@ func GetChildren(ctx context.Context) Component {
 	return *v.children
 }
 
+// WithSlot adds a named slot to the context. Like children, the slots are passed to the next
+// template that's rendered, which renders them with { slot("name")... } expressions.
+func WithSlot(ctx context.Context, name string, c Component) context.Context {
+	ctx, v := getContext(ctx)
+	if v.slots == nil {
+		v.slots = Slots{}
+	}
+	v.slots[name] = c
+	return ctx
+}
+
+// Slots are the named slots passed to a template.
+type Slots map[string]Component
+
+// GetSlots returns the named slots from the context.
+func GetSlots(ctx context.Context) Slots {
+	_, v := getContext(ctx)
+	return v.slots
+}
+
+// Get returns the named slot. If the slot wasn't passed, the fallback component is returned
+// instead, or NopComponent if there isn't a fallback.
+func (s Slots) Get(name string, fallback ...Component) Component {
+	if c, ok := s[name]; ok && c != nil {
+		return c
+	}
+	if len(fallback) > 0 && fallback[0] != nil {
+		return fallback[0]
+	}
+	return NopComponent
+}
+
+// Error is returned by generated templates when a component that they render returns an
+// error. It contains the name of the template, and the position of the failing node in the
+// templ file. If a nested template failed, Err is the *Error returned by the nested template.
+type Error struct {
+	Err error
+	// Name of the template, e.g. Card.
+	Name string
+	// FileName of the templ file, e.g. card.templ.
+	FileName string
+	// Line and Col are the 1-based position of the failing node in the templ file.
+	// They are zero if the position is unknown.
+	Line int
+	Col  int
+}
+
+// Error returns the chain of template names, the position of the innermost template's
+// failing node, and the underlying error, e.g. "Page > Layout > Card at card.templ:12:5: boom".
+func (e *Error) Error() string {
+	names := []string{e.Name}
+	inner := e
+	for {
+		next, ok := inner.Err.(*Error)
+		if !ok {
+			break
+		}
+		names = append(names, next.Name)
+		inner = next
+	}
+	var sb strings.Builder
+	sb.WriteString(strings.Join(names, " > "))
+	if pos := inner.position(); pos != "" {
+		sb.WriteString(" at ")
+		sb.WriteString(pos)
+	}
+	if inner.Err != nil {
+		sb.WriteString(": ")
+		sb.WriteString(inner.Err.Error())
+	}
+	return sb.String()
+}
+
+func (e *Error) position() string {
+	switch {
+	case e.Line == 0:
+		return e.FileName
+	case e.FileName == "":
+		return fmt.Sprintf("line %d, col %d", e.Line, e.Col)
+	default:
+		return fmt.Sprintf("%s:%d:%d", e.FileName, e.Line, e.Col)
+	}
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Observer is notified when templates start and finish rendering. It can be used to record
+// render timings in traces, or metrics. Observers are set on the context with WithObserver.
+type Observer interface {
+	// OnRenderStart is called before a template renders. The returned context is used to render
+	// the template, and is passed to OnRenderEnd, e.g. to add a tracing span to the context.
+	OnRenderStart(ctx context.Context, info RenderInfo) context.Context
+	// OnRenderEnd is called when a template has finished rendering, with the number of bytes
+	// that it wrote, and the error that it returned, if any.
+	OnRenderEnd(ctx context.Context, info RenderInfo, bytesWritten int, err error)
+}
+
+// RenderInfo describes a template that's being rendered.
+type RenderInfo struct {
+	// Name of the template, e.g. "page".
+	Name string
+	// Package is the name of the Go package that contains the template.
+	Package string
+}
+
+// The Observer is stored under its own context key, rather than in the render context, so that
+// renders without an Observer don't pay for it.
+type observerContextKeyType int
+
+const (
+	observerContextKey = observerContextKeyType(iota)
+	observedRenderContextKey
+)
+
+// WithObserver sets the Observer that's notified when templates that are rendered with the
+// context start and finish rendering.
+func WithObserver(ctx context.Context, o Observer) context.Context {
+	return context.WithValue(ctx, observerContextKey, o)
+}
+
+// GetObserver returns the Observer set on the context, or nil if there isn't one. It's used by
+// generated code.
+func GetObserver(ctx context.Context) Observer {
+	o, _ := ctx.Value(observerContextKey).(Observer)
+	return o
+}
+
+// StartRender notifies the Observer that a template has started rendering to w. It's used by
+// generated code.
+func StartRender(ctx context.Context, o Observer, name, pkg string, w *bytes.Buffer) (context.Context, *ObservedRender) {
+	r := &ObservedRender{
+		observer: o,
+		info: RenderInfo{
+			Name:    name,
+			Package: pkg,
+		},
+		w:     w,
+		start: w.Len(),
+	}
+	r.parent, _ = ctx.Value(observedRenderContextKey).(*ObservedRender)
+	// Flush finds the renders on the context, to count the bytes that it writes out of w.
+	ctx = context.WithValue(ctx, observedRenderContextKey, r)
+	r.ctx = o.OnRenderStart(ctx, r.info)
+	return r.ctx, r
+}
+
+// ObservedRender is the render of a template that's being observed. It's used by generated code.
+type ObservedRender struct {
+	ctx      context.Context
+	observer Observer
+	info     RenderInfo
+	w        *bytes.Buffer
+	// parent is the observed render of the template that's rendering this one, if any.
+	parent *ObservedRender
+	// start is the number of bytes that had been written to w when the template started
+	// rendering.
+	start int
+	// flushed is the number of bytes that Flush has written out of w since the template started
+	// rendering.
+	flushed  int
+	written  int
+	measured bool
+}
+
+// Rendered records the number of bytes written by the template. It must be called before the
+// buffer is written to the output.
+func (r *ObservedRender) Rendered() {
+	r.written = r.w.Len() + r.flushed - r.start
+	r.measured = true
+}
+
+// addFlushed records that Flush has written n bytes out of b, for each observed render on the
+// context that's rendering into b.
+func addFlushed(ctx context.Context, b *bytes.Buffer, n int) {
+	r, _ := ctx.Value(observedRenderContextKey).(*ObservedRender)
+	for ; r != nil; r = r.parent {
+		if r.w == b {
+			r.flushed += n
+		}
+	}
+}
+
+// End notifies the Observer that the template has finished rendering.
+func (r *ObservedRender) End(err error) {
+	if !r.measured {
+		r.Rendered()
+	}
+	r.observer.OnRenderEnd(r.ctx, r.info, r.written, err)
+}
+
+// Fragment creates a component that renders its children. When a template is rendered with
+// RenderFragments, only the output of the children of the named fragments is written.
+// Templates use the templ.Fragment("name") { ... } syntax to create fragments.
+func Fragment(name string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		ctx, v := getContext(ctx)
+		children := GetChildren(ctx)
+		ctx = ClearChildren(ctx)
+		f := v.fragments
+		if f == nil || f.inFragment {
+			return children.Render(ctx, w)
+		}
+		if _, ok := f.names[name]; !ok {
+			return children.Render(ctx, w)
+		}
+		// Nested fragments are written to w by the parent fragment's children.
+		f.inFragment = true
+		defer func() {
+			f.inFragment = false
+		}()
+		return children.Render(ctx, f.target)
+	})
+}
+
+// RenderFragments renders the component, but only writes the output of the fragments with
+// the given names to w. Output outside of the fragments is discarded. This allows the same
+// template to be used to render a full page, or a partial update.
+func RenderFragments(ctx context.Context, w io.Writer, c Component, names ...string) error {
+	ctx, v := getContext(ctx)
+	v.fragments = &fragmentRender{
+		names:  make(map[string]struct{}, len(names)),
+		target: w,
+	}
+	for _, name := range names {
+		v.fragments.names[name] = struct{}{}
+	}
+	defer func() {
+		v.fragments = nil
+	}()
+	return c.Render(ctx, io.Discard)
+}
+
+// fragmentRender is the state of a call to RenderFragments.
+type fragmentRender struct {
+	// names is the set of fragment names being rendered.
+	names map[string]struct{}
+	// target is the writer that fragment output is written to.
+	target io.Writer
+	// inFragment is true while the children of a fragment in names are being rendered.
+	inFragment bool
+}
+
+// Once creates a component that renders its children the first time that a Once component
+// with the key is rendered in a render context, e.g. to render the <script src> or
+// <link rel="stylesheet"> element used by a component once, however many times the component
+// is rendered.
+func Once(key string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		ctx, v := getContext(ctx)
+		children := GetChildren(ctx)
+		ctx = ClearChildren(ctx)
+		if v.isOutputDiscarded() {
+			// Don't mark the children as rendered, they're not being written to the output.
+			return children.Render(ctx, w)
+		}
+		if v.hasOnceBeenRendered(key) {
+			return nil
+		}
+		v.addOnce(key)
+		return children.Render(ctx, w)
+	})
+}
+
+// Head creates a component that renders its children into the <head> of the page, at the
+// position of the HeadOutlet, even if the outlet has already been rendered. If more than one
+// Head component with the same key is rendered, the content of the last one is used, e.g. to
+// allow a page to replace the <title> set by its layout. Content with an empty key is always
+// added.
+//
+// The content is added to the outlet by a ComponentHandler, or RenderWithHead. If the component
+// is rendered without them, the page doesn't have an outlet, or the outlet has already been sent
+// to the client by a streaming ComponentHandler, the children are rendered in place instead.
+func Head(key string) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		ctx, v := getContext(ctx)
+		children := GetChildren(ctx)
+		ctx = ClearChildren(ctx)
+		if v.head == nil || v.head.written {
+			return children.Render(ctx, w)
+		}
+		b := GetBuffer()
+		defer ReleaseBuffer(b)
+		if err = children.Render(ctx, b); err != nil {
+			return err
+		}
+		v.head.add(key, b.Bytes())
+		// The content is also rendered in place between markers, in case the page doesn't have an
+		// outlet. If it does, the content between the markers is removed. It's a single write, so
+		// that a streaming ComponentHandler receives the markers together.
+		content := make([]byte, 0, len(headContentStartMarker)+b.Len()+len(headContentEndMarker))
+		content = append(content, headContentStartMarker...)
+		content = append(content, b.Bytes()...)
+		content = append(content, headContentEndMarker...)
+		_, err = w.Write(content)
+		return err
+	})
+}
+
+// HeadOutlet creates a component that marks the position in the <head> of the page where the
+// content of Head components is added. If the component is rendered without a ComponentHandler,
+// or RenderWithHead, nothing is rendered.
+func HeadOutlet() Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		_, v := getContext(ctx)
+		if v.head == nil || v.head.written {
+			return nil
+		}
+		_, err = io.WriteString(w, headOutletMarker)
+		return err
+	})
+}
+
+// RenderWithHead renders the component to w, with the content of any Head components added to
+// the HeadOutlet. The output is buffered until rendering is complete.
+func RenderWithHead(ctx context.Context, w io.Writer, c Component) (err error) {
+	ctx, v := getContext(ctx)
+	head := v.head
+	v.head = &headContent{}
+	defer func() {
+		v.head = head
+	}()
+	b := GetBuffer()
+	defer ReleaseBuffer(b)
+	if err = c.Render(ctx, b); err != nil {
+		return err
+	}
+	_, err = w.Write(v.head.splice(b.Bytes()))
+	return err
+}
+
+// headOutletMarker is rendered by the HeadOutlet, and replaced with the content of the Head
+// components.
+const headOutletMarker = "<!--templ-head-outlet-->"
+
+// headContentStartMarker and headContentEndMarker surround the content of Head components that's
+// rendered in place.
+const (
+	headContentStartMarker = "<!--templ-head-->"
+	headContentEndMarker   = "<!--/templ-head-->"
+)
+
+// removeHeadMarkers removes the markers around the content of Head components that's rendered in
+// place. If removeContent is true, the content is removed too.
+func removeHeadMarkers(p []byte, removeContent bool) []byte {
+	if !bytes.Contains(p, []byte(headContentStartMarker)) {
+		return p
+	}
+	output := make([]byte, 0, len(p))
+	for {
+		start := bytes.Index(p, []byte(headContentStartMarker))
+		if start < 0 {
+			break
+		}
+		end := bytes.Index(p[start:], []byte(headContentEndMarker))
+		if end < 0 {
+			break
+		}
+		end += start
+		output = append(output, p[:start]...)
+		if !removeContent {
+			output = append(output, p[start+len(headContentStartMarker):end]...)
+		}
+		p = p[end+len(headContentEndMarker):]
+	}
+	return append(output, p...)
+}
+
+// HeadItem is the content rendered by a Head component.
+type HeadItem struct {
+	Key     string
+	Content []byte
+}
+
+// headContent collects the content of Head components.
+type headContent struct {
+	items []HeadItem
+	// keys is the index of each key within items.
+	keys map[string]int
+	// written is true once the content has been added to the outlet.
+	written bool
+}
+
+func (h *headContent) add(key string, content []byte) {
+	item := HeadItem{
+		Key:     key,
+		Content: append([]byte(nil), content...),
+	}
+	if key != "" {
+		if i, ok := h.keys[key]; ok {
+			h.items[i] = item
+			return
+		}
+		if h.keys == nil {
+			h.keys = map[string]int{}
+		}
+		h.keys[key] = len(h.items)
+	}
+	h.items = append(h.items, item)
+}
+
+// splice replaces the outlet marker in p with the content, and removes the content that was
+// rendered in place. If p doesn't contain the outlet, the content is left in place. Either way,
+// the content is written, and any later Head components render their content in place.
+func (h *headContent) splice(p []byte) []byte {
+	if h.written {
+		return p
+	}
+	h.written = true
+	i := bytes.Index(p, []byte(headOutletMarker))
+	if i < 0 {
+		return removeHeadMarkers(p, false)
+	}
+	var content []byte
+	for _, item := range h.items {
+		content = append(content, item.Content...)
+	}
+	output := make([]byte, 0, len(p)-len(headOutletMarker)+len(content))
+	output = append(output, p[:i]...)
+	output = append(output, content...)
+	output = append(output, p[i+len(headOutletMarker):]...)
+	return removeHeadMarkers(output, true)
+}
+
+func (h *headContent) copy() *headContent {
+	if h == nil {
+		return nil
+	}
+	c := &headContent{
+		items:   append([]HeadItem(nil), h.items...),
+		written: h.written,
+	}
+	if h.keys != nil {
+		c.keys = make(map[string]int, len(h.keys))
+		for k, i := range h.keys {
+			c.keys[k] = i
+		}
+	}
+	return c
+}
+
+// headWriter adds the content of Head components to the outlet as the output is streamed.
+type headWriter struct {
+	w    io.Writer
+	head *headContent
+}
+
+func (hw headWriter) Write(p []byte) (n int, err error) {
+	if _, err = hw.w.Write(hw.head.splice(p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (hw headWriter) Flush() {
+	if f, ok := hw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// ErrorBoundary creates a component that renders the child. If the child returns an error, or
+// panics, its output is discarded, and the component returned by fallback is rendered instead.
+// The CSS classes and scripts registered by the failed render are discarded too, so that
+// they're rendered again by later components. If fallback is nil, nothing is rendered.
+func ErrorBoundary(child Component, fallback func(err error) Component) ErrorBoundaryComponent {
+	return ErrorBoundaryComponent{
+		Child:    child,
+		Fallback: fallback,
+	}
+}
+
+// ErrorBoundaryComponent is a component created by ErrorBoundary.
+type ErrorBoundaryComponent struct {
+	Child    Component
+	Fallback func(err error) Component
+	// Logger is called with the error returned by the child, if it's set.
+	Logger func(err error)
+}
+
+// WithLogger sets the function that's called with the error returned by the child.
+func (eb ErrorBoundaryComponent) WithLogger(logger func(err error)) ErrorBoundaryComponent {
+	eb.Logger = logger
+	return eb
+}
+
+func (eb ErrorBoundaryComponent) Render(ctx context.Context, w io.Writer) (err error) {
+	ctx, v := getContext(ctx)
+	state := v.saveState()
+	b := GetBuffer()
+	defer ReleaseBuffer(b)
+	var fragments *bytes.Buffer
+	if v.isOutputDiscarded() {
+		// Fragments within the child are written to the fragment target, so they're held back
+		// until the child has rendered.
+		fragments = GetBuffer()
+		defer ReleaseBuffer(fragments)
+	}
+	if err = eb.renderChild(ctx, v, b, fragments); err == nil {
+		if fragments != nil {
+			if _, err = fragments.WriteTo(v.fragments.target); err != nil {
+				return err
+			}
+		}
+		_, err = b.WriteTo(w)
+		return err
+	}
+	if eb.Logger != nil {
+		eb.Logger(err)
+	}
+	v.restoreState(state)
+	if eb.Fallback == nil {
+		return nil
+	}
+	if fallback := eb.Fallback(err); fallback != nil {
+		return fallback.Render(ctx, w)
+	}
+	return nil
+}
+
+func (eb ErrorBoundaryComponent) renderChild(ctx context.Context, v *contextValue, w, fragments *bytes.Buffer) (err error) {
+	// Flush would write the child's partial output to the client.
+	flushTarget := v.flushTarget
+	v.flushTarget = nil
+	if fragments != nil {
+		fragmentTarget := v.fragments.target
+		v.fragments.target = fragments
+		defer func() {
+			v.fragments.target = fragmentTarget
+		}()
+	}
+	defer func() {
+		v.flushTarget = flushTarget
+		if r := recover(); r != nil {
+			if rerr, ok := r.(error); ok {
+				err = fmt.Errorf("templ: render panicked: %w", rerr)
+				return
+			}
+			err = fmt.Errorf("templ: render panicked: %v", r)
+		}
+	}()
+	if eb.Child == nil {
+		return nil
+	}
+	return eb.Child.Render(ctx, w)
+}
+
+// renderState is the state of the context that's rolled back when an ErrorBoundary's child
+// fails to render.
+type renderState struct {
+	ss        map[string]struct{}
+	cacheDeps *cacheDeps
+	hashes    CSPHashes
+	head      *headContent
+}
+
+func (v *contextValue) saveState() (s renderState) {
+	s.ss = copySet(v.ss)
+	if v.cacheDeps != nil {
+		s.cacheDeps = &cacheDeps{
+			requires: copySet(v.cacheDeps.requires),
+			adds:     copySet(v.cacheDeps.adds),
+		}
+	}
+	if v.cspHashes != nil {
+		// Hashes are only appended, so the slices can be restored to their current length.
+		s.hashes = *v.cspHashes
+	}
+	s.head = v.head.copy()
+	return s
+}
+
+func (v *contextValue) restoreState(s renderState) {
+	v.ss = s.ss
+	if v.cacheDeps != nil {
+		*v.cacheDeps = *s.cacheDeps
+	}
+	if v.cspHashes != nil {
+		*v.cspHashes = s.hashes
+	}
+	// The head content is updated in place, since it's shared with the ComponentHandler.
+	if v.head != nil && s.head != nil {
+		*v.head = *s.head
+	}
+}
+
+// detach returns a copy of the render context that's safe to use concurrently with v.
+func (v *contextValue) detach() *contextValue {
+	return &contextValue{
+		ss:        copySet(v.ss),
+		nonce:     v.nonce,
+		scriptSrc: v.scriptSrc,
+	}
+}
+
+func copySet(m map[string]struct{}) map[string]struct{} {
+	c := make(map[string]struct{}, len(m))
+	for k := range m {
+		c[k] = struct{}{}
+	}
+	return c
+}
+
+// Async creates a component that renders the placeholder, and calls resolve concurrently with
+// rendering the rest of the page. When rendered by a ComponentHandler, the resolved component is
+// rendered at the end of the response, and a script replaces the placeholder with it. If the
+// ComponentHandler is streaming, each resolved component is sent to the client as soon as it's
+// ready. Outside of a ComponentHandler, resolve is called, and the resolved component rendered,
+// in place of the placeholder.
+//
+// The context passed to resolve has its own copy of the render state, so resolve can use it to
+// render components while the page is rendered. Anything that it renders isn't recorded in the
+// page's render state.
+func Async(placeholder Component, resolve func(ctx context.Context) (Component, error)) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		ctx, v := getContext(ctx)
+		if v.async == nil {
+			c, err := resolve(ctx)
+			if err != nil {
+				return err
+			}
+			return c.Render(ctx, w)
+		}
+		id := v.async.start(context.WithValue(ctx, contextKey, v.detach()), resolve)
+		if _, err = io.WriteString(w, `<templ-async id="`+asyncPlaceholderID(id)+`">`); err != nil {
+			return err
+		}
+		if err = placeholder.Render(ctx, w); err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, `</templ-async>`)
+		return err
+	})
+}
+
+func asyncPlaceholderID(id int) string {
+	return "templ-async-" + strconv.Itoa(id)
+}
+
+// asyncRenderer coordinates the Async components of a render. Resolve functions run in their
+// own goroutines, but resolved components are rendered by the goroutine that renders the page,
+// since the render context isn't safe for concurrent use.
+type asyncRenderer struct {
+	// nextID and pending are only used by the render goroutine.
+	nextID  int
+	pending int
+	// m protects resolved.
+	m        sync.Mutex
+	resolved []asyncResult
+	// notify is signalled when a component is resolved.
+	notify chan struct{}
+}
+
+type asyncResult struct {
+	id  int
+	c   Component
+	err error
+}
+
+func newAsyncRenderer() *asyncRenderer {
+	return &asyncRenderer{
+		notify: make(chan struct{}, 1),
+	}
+}
+
+func (ar *asyncRenderer) start(ctx context.Context, resolve func(ctx context.Context) (Component, error)) (id int) {
+	ar.nextID++
+	ar.pending++
+	id = ar.nextID
+	go func() {
+		c, err := resolve(ctx)
+		ar.m.Lock()
+		ar.resolved = append(ar.resolved, asyncResult{id: id, c: c, err: err})
+		ar.m.Unlock()
+		// Don't block if the render goroutine has already been notified, or has stopped waiting.
+		select {
+		case ar.notify <- struct{}{}:
+		default:
+		}
+	}()
+	return id
+}
+
+// render writes each resolved component to w in the order that they're resolved, calling
+// flush after each one, until all of the Async components that have been started are rendered.
+func (ar *asyncRenderer) render(ctx context.Context, w io.Writer, flush func()) error {
+	for ar.pending > 0 {
+		select {
+		case <-ar.notify:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		ar.m.Lock()
+		resolved := ar.resolved
+		ar.resolved = nil
+		ar.m.Unlock()
+		for _, r := range resolved {
+			ar.pending--
+			if r.err != nil {
+				return r.err
+			}
+			if err := renderAsyncResult(ctx, w, r); err != nil {
+				return err
+			}
+			flush()
+		}
+	}
+	return nil
+}
+
+func renderAsyncResult(ctx context.Context, w io.Writer, r asyncResult) (err error) {
+	_, v := getContext(ctx)
+	id := asyncPlaceholderID(r.id)
+	if _, err = io.WriteString(w, `<template id="`+id+`-content">`); err != nil {
+		return err
+	}
+	if err = r.c.Render(ctx, w); err != nil {
+		return err
+	}
+	if _, err = io.WriteString(w, `</template>`); err != nil {
+		return err
+	}
+	swap := `(function(){var p=document.getElementById("` + id + `"),t=document.getElementById("` + id + `-content");if(p&&t){p.replaceWith(t.content);t.remove()}})()`
+	v.addScriptHash(swap)
+	_, err = io.WriteString(w, `<script type="text/javascript"`+nonceAttribute(v.nonce)+`>`+swap+`</script>`)
+	return err
+}
+
+// Cached creates a component that renders c, and stores the output in the Cache set on the
+// context with WithCache, or the DefaultCache, for the ttl. While the output is in the cache,
+// it's written directly instead of rendering c. A ttl of zero or less stores the output until
+// it's evicted from the cache.
+//
+// The CSS classes and scripts rendered by c are registered in the render context on cache hits,
+// so that they're not rendered again. If the cached output can't be used because it would
+// render CSS or scripts that have already been rendered, or depends on CSS or scripts that
+// haven't been rendered, c is rendered instead.
+func Cached(key string, ttl time.Duration, c Component) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		ctx, v := getContext(ctx)
+		if v.fragments != nil || v.emailStyles != nil {
+			// The output of components rendered by RenderFragments depends on the fragments, and
+			// RenderEmail needs the CSS classes that are rendered.
+			return c.Render(ctx, w)
+		}
+		cache := getCache(ctx)
+		if entry, ok := cache.Get(key); ok && v.canUseCacheEntry(entry) {
+			return v.writeCacheEntry(w, entry)
+		}
+		entry, err := v.renderCacheEntry(ctx, c)
+		if err != nil {
+			return err
+		}
+		cache.Set(key, entry, ttl)
+		return v.writeCacheEntry(w, entry)
+	})
+}
+
+// Cache stores the rendered output of Cached components.
+type Cache interface {
+	// Get returns the entry stored for the key, if it exists and hasn't expired.
+	Get(key string) (entry CacheEntry, ok bool)
+	// Set stores the entry for the key. If ttl is greater than zero, the entry expires after ttl.
+	Set(key string, entry CacheEntry, ttl time.Duration)
+}
+
+// CacheEntry is the rendered output of a Cached component.
+type CacheEntry struct {
+	// Output is the rendered HTML.
+	Output []byte
+	// Requires is the set of items that must have been rendered for the output to be used.
+	Requires []string
+	// Adds is the set of items that the output renders, which must not have been rendered for
+	// the output to be used.
+	Adds []string
+	// Hashes are the Content Security Policy hashes of the output.
+	Hashes CSPHashes
+	// Head is the content rendered by Head components within the output.
+	Head []HeadItem
+}
+
+// DefaultCache is the Cache used by Cached components, unless a Cache is set on the context with
+// WithCache. It can be replaced at startup to use a different Cache implementation.
+var DefaultCache Cache = NewMemoryCache(1024)
+
+type cacheContextKeyType int
+
+const cacheContextKey = cacheContextKeyType(0)
+
+// WithCache sets the Cache used by Cached components that are rendered with the context, e.g. to
+// use a separate cache for each tenant, or in tests.
+func WithCache(ctx context.Context, c Cache) context.Context {
+	return context.WithValue(ctx, cacheContextKey, c)
+}
+
+// getCache returns the Cache set on the context, or the DefaultCache.
+func getCache(ctx context.Context) Cache {
+	if c, ok := ctx.Value(cacheContextKey).(Cache); ok && c != nil {
+		return c
+	}
+	return DefaultCache
+}
+
+type cacheDeps struct {
+	requires map[string]struct{}
+	adds     map[string]struct{}
+}
+
+// cachedNonce is rendered in place of the Content Security Policy nonce within Cached
+// components, and replaced with the nonce of the current render when the output is written.
+const cachedNonce = "templ-cached-nonce-f2b6cc8a"
+
+func (v *contextValue) canUseCacheEntry(entry CacheEntry) bool {
+	for _, key := range entry.Requires {
+		if _, ok := v.ss[key]; !ok {
+			return false
+		}
+	}
+	for _, key := range entry.Adds {
+		if _, ok := v.ss[key]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// renderCacheEntry renders the component with a copy of the render context, recording the
+// items that it depends on.
+func (v *contextValue) renderCacheEntry(ctx context.Context, c Component) (entry CacheEntry, err error) {
+	cv := &contextValue{
+		ss:        copySet(v.ss),
+		nonce:     cachedNonce,
+		scriptSrc: v.scriptSrc,
+		cacheDeps: &cacheDeps{
+			requires: map[string]struct{}{},
+			adds:     map[string]struct{}{},
+		},
+		cspHashes: &CSPHashes{},
+		// The head content is always collected, so that it can be added to the outlet, or
+		// written in place, depending on how the entry is rendered.
+		head: &headContent{},
+	}
+	if v.children != nil {
+		children := *v.children
+		cv.children = &children
+	}
+	cv.slots = v.slots
+	b := GetBuffer()
+	defer ReleaseBuffer(b)
+	if err = c.Render(context.WithValue(ctx, contextKey, cv), b); err != nil {
+		return entry, err
+	}
+	entry.Output = append([]byte(nil), b.Bytes()...)
+	entry.Requires = sortedKeys(cv.cacheDeps.requires)
+	entry.Adds = sortedKeys(cv.cacheDeps.adds)
+	entry.Hashes = *cv.cspHashes
+	entry.Head = cv.head.items
+	return entry, nil
+}
+
+// writeCacheEntry writes the output of the entry, and updates the render context as if the
+// cached component had been rendered.
+func (v *contextValue) writeCacheEntry(w io.Writer, entry CacheEntry) (err error) {
+	for _, key := range entry.Requires {
+		v.has(key)
+	}
+	for _, key := range entry.Adds {
+		v.add(key)
+	}
+	if v.cspHashes != nil {
+		v.cspHashes.Scripts = append(v.cspHashes.Scripts, entry.Hashes.Scripts...)
+		v.cspHashes.Styles = append(v.cspHashes.Styles, entry.Hashes.Styles...)
+		v.cspHashes.ScriptAttributes = append(v.cspHashes.ScriptAttributes, entry.Hashes.ScriptAttributes...)
+		v.cspHashes.StyleAttributes = append(v.cspHashes.StyleAttributes, entry.Hashes.StyleAttributes...)
+	}
+	output := entry.Output
+	if v.head == nil || v.head.written {
+		// The output contains the head content in place, so it's written there.
+		output = removeHeadMarkers(output, false)
+	} else {
+		for _, item := range entry.Head {
+			v.head.add(item.Key, v.replaceCachedNonce(item.Content))
+		}
+	}
+	_, err = w.Write(v.replaceCachedNonce(output))
+	return err
+}
+
+// replaceCachedNonce replaces the nonce rendered within Cached components with the nonce of
+// the current render.
+func (v *contextValue) replaceCachedNonce(output []byte) []byte {
+	if !bytes.Contains(output, []byte(cachedNonce)) {
+		return output
+	}
+	if v.nonce == "" {
+		output = bytes.ReplaceAll(output, []byte(nonceAttribute(cachedNonce)), nil)
+	}
+	return bytes.ReplaceAll(output, []byte(cachedNonce), []byte(EscapeString(v.nonce)))
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// NewMemoryCache creates an in-memory Cache that holds up to capacity entries. When the cache
+// is full, the least recently used entry is evicted.
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{
+		capacity: capacity,
+		items:    map[string]*list.Element{},
+		lru:      list.New(),
+	}
+}
+
+// MemoryCache is an in-memory, least recently used Cache.
+type MemoryCache struct {
+	capacity int
+	m        sync.Mutex
+	items    map[string]*list.Element
+	// lru contains *memoryCacheItem values, with the most recently used at the front.
+	lru *list.List
+}
+
+type memoryCacheItem struct {
+	key     string
+	entry   CacheEntry
+	expires time.Time
+}
+
+func (mc *MemoryCache) Get(key string) (entry CacheEntry, ok bool) {
+	mc.m.Lock()
+	defer mc.m.Unlock()
+	e, ok := mc.items[key]
+	if !ok {
+		return entry, false
+	}
+	item := e.Value.(*memoryCacheItem)
+	if !item.expires.IsZero() && time.Now().After(item.expires) {
+		mc.lru.Remove(e)
+		delete(mc.items, key)
+		return entry, false
+	}
+	mc.lru.MoveToFront(e)
+	return item.entry, true
+}
+
+func (mc *MemoryCache) Set(key string, entry CacheEntry, ttl time.Duration) {
+	mc.m.Lock()
+	defer mc.m.Unlock()
+	item := &memoryCacheItem{
+		key:   key,
+		entry: entry,
+	}
+	if ttl > 0 {
+		item.expires = time.Now().Add(ttl)
+	}
+	if e, ok := mc.items[key]; ok {
+		e.Value = item
+		mc.lru.MoveToFront(e)
+		return
+	}
+	mc.items[key] = mc.lru.PushFront(item)
+	for mc.lru.Len() > mc.capacity {
+		oldest := mc.lru.Back()
+		mc.lru.Remove(oldest)
+		delete(mc.items, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
 // ComponentHandler is a http.Handler that renders components.
 type ComponentHandler struct {
 	Component    Component
 	Status       int
 	ContentType  string
 	ErrorHandler func(r *http.Request, err error) http.Handler
+	// Streaming writes rendered output to the client each time a Flush component
+	// is rendered, instead of once rendering is complete.
+	Streaming bool
 }
 
 const componentHandlerErrorMessage = "templ: failed to render template"
 
 // ServeHTTP implements the http.Handler interface.
 func (ch ComponentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if ch.Streaming {
+		ch.serveStreamingHTTP(w, r)
+		return
+	}
+	// Render into a buffer, so that nothing is written to the client if rendering fails.
+	b := GetBuffer()
+	defer ReleaseBuffer(b)
+	ctx, v := getContext(r.Context())
+	v.async = newAsyncRenderer()
+	v.head = &headContent{}
+	err := ch.Component.Render(ctx, b)
+	if err == nil {
+		// Append the components of any Async components once they've resolved.
+		err = v.async.render(ctx, b, func() {})
+	}
+	if err != nil {
+		ch.serveErrorHTTP(w, r, err)
+		return
+	}
+	w.Header().Add("Content-Type", ch.ContentType)
 	if ch.Status != 0 {
 		w.WriteHeader(ch.Status)
 	}
+	// Ignore write errors, the client has gone away.
+	_, _ = w.Write(v.head.splice(b.Bytes()))
+}
+
+func (ch ComponentHandler) serveStreamingHTTP(w http.ResponseWriter, r *http.Request) {
+	// Give Flush components a target to write to.
+	ctx, v := getContext(r.Context())
+	v.head = &headContent{}
+	hw := headWriter{w: w, head: v.head}
+	v.flushTarget = hw
+	v.async = newAsyncRenderer()
 	w.Header().Add("Content-Type", ch.ContentType)
-	err := ch.Component.Render(r.Context(), w)
-	if err != nil {
-		if ch.ErrorHandler != nil {
-			ch.ErrorHandler(r, err).ServeHTTP(w, r)
-			return
+	if ch.Status != 0 {
+		w.WriteHeader(ch.Status)
+	}
+	flush := func() {
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
 		}
-		http.Error(w, componentHandlerErrorMessage, http.StatusInternalServerError)
 	}
+	err := ch.Component.Render(ctx, hw)
+	if err == nil {
+		// Send the page, including the placeholders of any Async components, then
+		// stream each resolved component as soon as it's ready.
+		flush()
+		err = v.async.render(ctx, hw, flush)
+	}
+	if err != nil {
+		// The status and some of the body may have been sent already, so the error
+		// response is appended to the output.
+		ch.serveErrorHTTP(w, r, err)
+	}
+}
+
+func (ch ComponentHandler) serveErrorHTTP(w http.ResponseWriter, r *http.Request, err error) {
+	if ch.ErrorHandler != nil {
+		ch.ErrorHandler(r, err).ServeHTTP(w, r)
+		return
+	}
+	http.Error(w, componentHandlerErrorMessage, http.StatusInternalServerError)
 }
 
 // Handler creates a http.Handler that renders the template.
@@ -118,13 +1155,189 @@ func WithErrorHandler(eh func(r *http.Request, err error) http.Handler) func(*Co
 	}
 }
 
+// WithStreaming sets the ComponentHandler to stream the response. Output rendered before a
+// templ.Flush component is written to the client immediately, instead of once rendering is complete.
+// Since the status code is sent before rendering starts, render errors can't be reported with a
+// clean error response when streaming.
+func WithStreaming() func(*ComponentHandler) {
+	return func(ch *ComponentHandler) {
+		ch.Streaming = true
+	}
+}
+
+// Flush creates a component that writes any output rendered so far to the client, and flushes
+// the http.ResponseWriter if it implements http.Flusher.
+// Flush only has an effect when rendered by a ComponentHandler that has streaming enabled.
+func Flush() Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		_, v := getContext(ctx)
+		if v.flushTarget == nil {
+			return nil
+		}
+		// Templates render into a buffer, write its contents out first.
+		if b, isBuffer := w.(*bytes.Buffer); isBuffer {
+			n, err := b.WriteTo(v.flushTarget)
+			addFlushed(ctx, b, int(n))
+			if err != nil {
+				return err
+			}
+		}
+		if f, ok := v.flushTarget.(http.Flusher); ok {
+			f.Flush()
+		}
+		return nil
+	})
+}
+
 // EscapeString escapes HTML text within templates.
 func EscapeString(s string) string {
 	return html.EscapeString(s)
 }
 
-// Bool attribute value.
-func Bool(value bool) bool {
+// Bool attribute value.
+func Bool(value bool) bool {
+	return value
+}
+
+// Attributes is a map of attribute names to values, spread onto an element using
+// the { attrs... } syntax.
+// Supported value types are string and bool. A true bool renders the attribute name
+// only, while a false bool omits the attribute. Other values are formatted with fmt.
+// Values of URL attributes, such as href and src, are formatted and sanitized with templ.URL,
+// use a templ.SafeURL value to bypass sanitization.
+// Attributes with invalid names are not rendered. Event handler attributes, such as onclick,
+// are also not rendered, since their values are JavaScript. Add them to the element instead.
+type Attributes map[string]any
+
+// RenderAttributes renders the attributes to the writer. Attributes are sorted by name
+// to produce consistent output.
+func RenderAttributes(ctx context.Context, w io.Writer, attributes Attributes) (err error) {
+	// In Go, map keys are iterated in a randomized order.
+	// So the keys in the map must be sorted to produce consistent output.
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !isValidAttributeName(key) || isScriptAttributeName(key) {
+			continue
+		}
+		var value string
+		switch v := attributes[key].(type) {
+		case nil:
+			continue
+		case bool:
+			if !v {
+				continue
+			}
+			if _, err = io.WriteString(w, " "+key); err != nil {
+				return err
+			}
+			continue
+		case SafeURL:
+			// SafeURL values bypass URL sanitization.
+			value = string(v)
+		case string:
+			value = sanitizeSpreadAttributeValue(key, v)
+		default:
+			value = sanitizeSpreadAttributeValue(key, fmt.Sprint(v))
+		}
+		if strings.EqualFold(key, "style") {
+			AddStyleAttributeHash(ctx, value)
+		}
+		if _, err = io.WriteString(w, " "+key+`="`+EscapeString(value)+`"`); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var attributeNameRegexp = regexp.MustCompile(`^[a-zA-Z_:][-a-zA-Z0-9_:.]*$`)
+
+// isValidAttributeName returns true if the name can be rendered as an attribute name without
+// escaping.
+func isValidAttributeName(name string) bool {
+	return attributeNameRegexp.MatchString(name)
+}
+
+// isScriptAttributeName returns true if the value of the attribute is JavaScript, e.g. onclick.
+// It covers HTML event handlers, and htmx's hx-on attributes in all of their forms, e.g.
+// hx-on:click, hx-on-click, hx-on--after-request and data-hx-on:click. Attributes of other
+// libraries that contain JavaScript aren't covered.
+func isScriptAttributeName(name string) bool {
+	name = strings.ToLower(name)
+	return strings.HasPrefix(name, "on") ||
+		strings.HasPrefix(name, "hx-on") ||
+		strings.HasPrefix(name, "data-hx-on")
+}
+
+// urlAttribute describes an attribute that contains URLs.
+type urlAttribute struct {
+	// elements the attribute contains a URL on. If nil, it contains a URL on any element.
+	elements []string
+	// isList is true if the value is a comma separated list of URLs with descriptors, e.g.
+	// <img srcset>.
+	isList bool
+}
+
+// urlAttributes is the table of attributes that contain URLs. It's unexported, so that it
+// can't be changed to weaken sanitization.
+// https://html.spec.whatwg.org/multipage/indices.html#attributes-3
+var urlAttributes = map[string]urlAttribute{
+	"action":      {elements: []string{"form"}},
+	"background":  {elements: []string{"body", "table", "td", "th"}},
+	"cite":        {elements: []string{"blockquote", "del", "ins", "q"}},
+	"codebase":    {elements: []string{"applet", "object"}},
+	"data":        {elements: []string{"object"}},
+	"formaction":  {elements: []string{"button", "input"}},
+	"href":        {},
+	"icon":        {elements: []string{"command", "menuitem"}},
+	"imagesrcset": {elements: []string{"link"}, isList: true},
+	"longdesc":    {elements: []string{"frame", "iframe", "img"}},
+	"manifest":    {elements: []string{"html"}},
+	"ping":        {elements: []string{"a", "area"}},
+	"poster":      {elements: []string{"video"}},
+	"src":         {},
+	"srcset":      {elements: []string{"img", "source"}, isList: true},
+	"xlink:href":  {},
+}
+
+// IsURLAttribute returns true if the attribute of the element contains a URL, and whether the
+// value is a list of URLs with descriptors, such as <img srcset>, that's sanitized with
+// SanitizeSrcsetAttribute. If element is empty, e.g. because the attribute is a spread attribute,
+// it returns true if the attribute contains a URL on any element. Names are case insensitive.
+func IsURLAttribute(element, name string) (isURL, isList bool) {
+	a, ok := urlAttributes[strings.ToLower(name)]
+	if !ok {
+		return false, false
+	}
+	if element != "" && a.elements != nil && !containsFold(a.elements, element) {
+		return false, false
+	}
+	return true, a.isList
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitizeSpreadAttributeValue sanitizes the formatted values of URL attributes. Since the
+// element isn't known, attributes that contain a URL on any element are sanitized. To bypass
+// sanitization, use a templ.SafeURL value.
+func sanitizeSpreadAttributeValue(name, value string) string {
+	isURL, isList := IsURLAttribute("", name)
+	switch {
+	case isList:
+		return string(SanitizeSrcsetAttribute(value))
+	case isURL:
+		return string(SanitizeURLAttribute(value))
+	}
 	return value
 }
 
@@ -331,6 +1544,12 @@ func (cssm CSSMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		cssm.CSSHandler.ServeHTTP(w, r)
 		return
 	}
+	if r.URL.Path == cssm.Href() {
+		// The content of the fingerprinted URL never changes, so it can be cached indefinitely.
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		cssm.CSSHandler.ServeHTTP(w, r)
+		return
+	}
 	// Add registered classes to the context.
 	ctx, v := getContext(r.Context())
 	for _, c := range cssm.CSSHandler.Classes {
@@ -342,6 +1561,13 @@ func (cssm CSSMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	cssm.Next.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// Href returns the fingerprinted URL of the global stylesheet, e.g. /styles/templ.<hash>.css
+// The URL changes when the CSS changes, so it can be cached by browsers indefinitely.
+func (cssm CSSMiddleware) Href() string {
+	ext := path.Ext(cssm.Path)
+	return strings.TrimSuffix(cssm.Path, ext) + "." + cssm.CSSHandler.Hash() + ext
+}
+
 // NewCSSHandler creates a handler that serves a stylesheet containing the CSS of the
 // classes passed in. This is used by the CSSMiddleware to provide global stylesheets
 // for templ components.
@@ -354,25 +1580,158 @@ func NewCSSHandler(classes ...CSSClass) CSSHandler {
 		}
 		ccssc = append(ccssc, ccss)
 	}
-	return CSSHandler{
+	cssh := CSSHandler{
 		Classes: ccssc,
 	}
+	cssh.stylesheet = cssh.render()
+	return cssh
 }
 
 // CSSHandler is a HTTP handler that serves CSS.
 type CSSHandler struct {
 	Logger  func(err error)
 	Classes []ComponentCSSClass
+	// stylesheet is the rendered stylesheet, computed once by NewCSSHandler.
+	stylesheet *stylesheet
+}
+
+// CSSCompression compresses a stylesheet for a HTTP Content-Encoding, e.g. gzip or br.
+type CSSCompression struct {
+	// Encoding is the value of the Content-Encoding header, e.g. gzip.
+	Encoding string
+	// NewWriter returns a writer that compresses data written to it into w.
+	NewWriter func(w io.Writer) io.WriteCloser
+}
+
+// GzipCSSCompression compresses stylesheets using gzip.
+var GzipCSSCompression = CSSCompression{
+	Encoding: "gzip",
+	NewWriter: func(w io.Writer) io.WriteCloser {
+		return gzip.NewWriter(w)
+	},
+}
+
+type stylesheet struct {
+	content []byte
+	hash    string
+	// compressed versions of the content, in order of preference.
+	compressed []compressedStylesheet
+}
+
+type compressedStylesheet struct {
+	encoding string
+	content  []byte
+}
+
+func (cssh CSSHandler) render() *stylesheet {
+	var b bytes.Buffer
+	for _, c := range cssh.Classes {
+		b.WriteString(string(c.Class))
+	}
+	hash := sha256.Sum256(b.Bytes())
+	return &stylesheet{
+		content: b.Bytes(),
+		hash:    hex.EncodeToString(hash[:8]),
+	}
+}
+
+func (cssh CSSHandler) getStylesheet() *stylesheet {
+	if cssh.stylesheet == nil {
+		return cssh.render()
+	}
+	return cssh.stylesheet
+}
+
+// WithCompression returns a copy of the handler that serves the stylesheet precompressed with
+// the first of the compressions that is accepted by the client. The stylesheet is compressed
+// once, when WithCompression is called.
+func (cssh CSSHandler) WithCompression(compressions ...CSSCompression) CSSHandler {
+	ss := *cssh.getStylesheet()
+	ss.compressed = make([]compressedStylesheet, 0, len(compressions))
+	for _, c := range compressions {
+		var b bytes.Buffer
+		cw := c.NewWriter(&b)
+		_, err := cw.Write(ss.content)
+		if err == nil {
+			err = cw.Close()
+		}
+		if err != nil {
+			if cssh.Logger != nil {
+				cssh.Logger(fmt.Errorf("templ: failed to compress stylesheet with %s: %w", c.Encoding, err))
+			}
+			continue
+		}
+		ss.compressed = append(ss.compressed, compressedStylesheet{encoding: c.Encoding, content: b.Bytes()})
+	}
+	cssh.stylesheet = &ss
+	return cssh
+}
+
+// Hash returns a hash of the content of the stylesheet.
+func (cssh CSSHandler) Hash() string {
+	return cssh.getStylesheet().hash
 }
 
 func (cssh CSSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ss := cssh.getStylesheet()
+	etag := `"` + ss.hash + `"`
 	w.Header().Set("Content-Type", "text/css")
-	for _, c := range cssh.Classes {
-		_, err := w.Write([]byte(c.Class))
-		if err != nil && cssh.Logger != nil {
-			cssh.Logger(err)
+	w.Header().Set("ETag", etag)
+	if w.Header().Get("Cache-Control") == "" {
+		// Browsers must check whether the stylesheet has changed before using a cached copy.
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	if len(ss.compressed) > 0 {
+		w.Header().Add("Vary", "Accept-Encoding")
+	}
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	content := ss.content
+	accepted := acceptedEncodings(r.Header.Get("Accept-Encoding"))
+	for _, c := range ss.compressed {
+		if _, ok := accepted[c.encoding]; ok {
+			w.Header().Set("Content-Encoding", c.encoding)
+			content = c.content
+			break
+		}
+	}
+	_, err := w.Write(content)
+	if err != nil && cssh.Logger != nil {
+		cssh.Logger(err)
+	}
+}
+
+// etagMatches returns true if the If-None-Match header value contains the etag.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptedEncodings returns the set of encodings in an Accept-Encoding header value,
+// excluding any with a quality value of zero.
+func acceptedEncodings(acceptEncoding string) map[string]struct{} {
+	accepted := map[string]struct{}{}
+	for _, candidate := range strings.Split(acceptEncoding, ",") {
+		encoding, params, _ := strings.Cut(candidate, ";")
+		encoding = strings.ToLower(strings.TrimSpace(encoding))
+		if encoding == "" {
+			continue
 		}
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(q, 64); err == nil && f == 0 {
+				continue
+			}
+		}
+		accepted[encoding] = struct{}{}
 	}
+	return accepted
 }
 
 // RenderCSSItems renders the CSS to the writer, if the items haven't already been rendered.
@@ -381,10 +1740,19 @@ func RenderCSSItems(ctx context.Context, w io.Writer, classes ...any) (err error
 		return nil
 	}
 	_, v := getContext(ctx)
+	if v.isOutputDiscarded() {
+		// Render the CSS within the fragments instead.
+		return nil
+	}
 	sb := new(strings.Builder)
 	for _, c := range classes {
 		switch ccc := c.(type) {
 		case ComponentCSSClass:
+			if v.emailStyles != nil {
+				// Emails have the properties of the class added to the elements instead.
+				v.emailStyles[ccc.ID] = classProperties(ccc.Class)
+				continue
+			}
 			if !v.hasClassBeenRendered(ccc.ID) {
 				sb.WriteString(string(ccc.Class))
 				v.addClass(ccc.ID)
@@ -400,7 +1768,8 @@ func RenderCSSItems(ctx context.Context, w io.Writer, classes ...any) (err error
 		}
 	}
 	if sb.Len() > 0 {
-		if _, err = io.WriteString(w, `<style type="text/css">`); err != nil {
+		v.addStyleHash(sb.String())
+		if _, err = io.WriteString(w, `<style type="text/css"`+nonceAttribute(v.nonce)+`>`); err != nil {
 			return err
 		}
 		if _, err = io.WriteString(w, sb.String()); err != nil {
@@ -441,6 +1810,39 @@ func URL(s string) SafeURL {
 // SafeURL is a URL that has been sanitized.
 type SafeURL string
 
+// SanitizeURLAttribute returns the value of a URL attribute, such as <img src>. SafeURL values
+// are used as-is, while other values are sanitized with templ.URL.
+func SanitizeURLAttribute[T ~string](value T) SafeURL {
+	if u, ok := any(value).(SafeURL); ok {
+		return u
+	}
+	return URL(string(value))
+}
+
+// SanitizeSrcsetAttribute returns the value of a srcset attribute, a comma separated list of
+// URLs with optional descriptors, e.g. "small.jpg 1x, large.jpg 2x". SafeURL values are used
+// as-is, while the URL of each image candidate in other values is sanitized with templ.URL.
+func SanitizeSrcsetAttribute[T ~string](value T) SafeURL {
+	if u, ok := any(value).(SafeURL); ok {
+		return u
+	}
+	candidates := strings.Split(string(value), ",")
+	for i, candidate := range candidates {
+		// Each candidate is a URL, followed by an optional descriptor, separated by whitespace.
+		trimmed := strings.TrimLeft(candidate, " \t\n\f\r")
+		if trimmed == "" {
+			continue
+		}
+		leader := candidate[:len(candidate)-len(trimmed)]
+		url, descriptor := trimmed, ""
+		if end := strings.IndexAny(trimmed, " \t\n\f\r"); end >= 0 {
+			url, descriptor = trimmed[:end], trimmed[end:]
+		}
+		candidates[i] = leader + string(URL(url)) + descriptor
+	}
+	return SafeURL(strings.Join(candidates, ","))
+}
+
 // Script handling.
 
 // SafeScript encodes unknown parameters for safety.
@@ -458,6 +1860,40 @@ func SafeScript(functionName string, params ...interface{}) string {
 	return sb.String()
 }
 
+// JSONScript renders a <script type="application/json"> element with the given id, containing
+// v encoded as JSON. The data can be read by client-side scripts with
+// JSON.parse(document.getElementById(id).textContent).
+//
+// The characters <, >, & and the U+2028 and U+2029 line terminators are escaped as JSON
+// unicode escapes, so that the data can't close the script element, or start a comment.
+func JSONScript(id string, v any) Component {
+	return ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, `<script type="application/json" id="`+EscapeString(id)+`">`); err != nil {
+			return err
+		}
+		if _, err = w.Write(data); err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, `</script>`)
+		return err
+	})
+}
+
+// JSONString returns v encoded as JSON, for use in attribute values, e.g.
+// <div data-user={ templ.JSONString(user) }>. The attribute value is escaped by templ when it's
+// rendered. If v can't be encoded as JSON, an empty string is returned.
+func JSONString(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
 type contextKeyType int
 
 const contextKey = contextKeyType(0)
@@ -465,36 +1901,119 @@ const contextKey = contextKeyType(0)
 type contextValue struct {
 	ss       map[string]struct{}
 	children *Component
+	// slots are the named slots passed to the next template, alongside its children.
+	slots Slots
+	// flushTarget is the writer that Flush components write to when streaming.
+	flushTarget io.Writer
+	// nonce is added to the script and style elements rendered by templ.
+	nonce string
+	// async coordinates Async components. It's set by the ComponentHandler.
+	async *asyncRenderer
+	// fragments is set while a component is rendered by RenderFragments.
+	fragments *fragmentRender
+	// scriptSrc is the path of the global script provided by the ScriptMiddleware.
+	scriptSrc string
+	// cacheDeps records the items that a Cached component depends on while it's rendered.
+	cacheDeps *cacheDeps
+	// cspHashes collects the hashes of the inline scripts and styles rendered by templ, if enabled.
+	cspHashes *CSPHashes
+	// head collects the content of Head components. It's nil unless the component is rendered
+	// by a ComponentHandler, or RenderWithHead.
+	head *headContent
+	// emailStyles is the properties of each CSS class rendered by RenderEmail.
+	emailStyles map[string]string
 }
 
-func (v *contextValue) addScript(s string) {
+// add records that an item has been rendered, or registered by middleware.
+func (v *contextValue) add(key string) {
 	if v.ss == nil {
 		v.ss = map[string]struct{}{}
 	}
-	v.ss["script_"+s] = struct{}{}
+	v.ss[key] = struct{}{}
+	if v.cacheDeps != nil {
+		v.cacheDeps.adds[key] = struct{}{}
+	}
 }
 
-func (v *contextValue) hasScriptBeenRendered(s string) (ok bool) {
+// has returns true if an item has been rendered, or registered by middleware.
+func (v *contextValue) has(key string) (ok bool) {
 	if v.ss == nil {
 		v.ss = map[string]struct{}{}
 	}
-	_, ok = v.ss["script_"+s]
+	_, ok = v.ss[key]
+	if ok && v.cacheDeps != nil {
+		v.cacheDeps.requires[key] = struct{}{}
+	}
 	return
 }
 
+func (v *contextValue) addScript(s string) {
+	v.add("script_" + s)
+}
+
+func (v *contextValue) hasScriptBeenRendered(s string) (ok bool) {
+	return v.has("script_" + s)
+}
+
 func (v *contextValue) addClass(s string) {
-	if v.ss == nil {
-		v.ss = map[string]struct{}{}
-	}
-	v.ss["class_"+s] = struct{}{}
+	v.add("class_" + s)
 }
 
 func (v *contextValue) hasClassBeenRendered(s string) (ok bool) {
-	if v.ss == nil {
-		v.ss = map[string]struct{}{}
+	return v.has("class_" + s)
+}
+
+func (v *contextValue) addOnce(key string) {
+	v.add("once_" + key)
+}
+
+func (v *contextValue) hasOnceBeenRendered(key string) (ok bool) {
+	return v.has("once_" + key)
+}
+
+func (v *contextValue) addBundledScript(s string) {
+	v.add("bundled_script_" + s)
+}
+
+func (v *contextValue) isScriptBundled(s string) (ok bool) {
+	return v.has("bundled_script_" + s)
+}
+
+// scriptSrcKey records that the reference to the global script has been rendered.
+const scriptSrcKey = "scriptsrc"
+
+// isOutputDiscarded returns true if the output is being discarded because it's outside of the
+// fragments being rendered by RenderFragments.
+func (v *contextValue) isOutputDiscarded() bool {
+	return v.fragments != nil && !v.fragments.inFragment
+}
+
+func (v *contextValue) addScriptHash(contents string) {
+	if v.cspHashes == nil {
+		return
 	}
-	_, ok = v.ss["class_"+s]
-	return
+	v.cspHashes.Scripts = append(v.cspHashes.Scripts, cspHash(contents))
+}
+
+func (v *contextValue) addScriptAttributeHash(contents string) {
+	if v.cspHashes == nil {
+		return
+	}
+	v.cspHashes.ScriptAttributes = append(v.cspHashes.ScriptAttributes, cspHash(contents))
+}
+
+func (v *contextValue) addStyleAttributeHash(contents string) {
+	if v.cspHashes == nil {
+		return
+	}
+	v.cspHashes.StyleAttributes = append(v.cspHashes.StyleAttributes, cspHash(contents))
+}
+
+func (v *contextValue) addStyleHash(contents string) {
+	if v.cspHashes == nil {
+		return
+	}
+	v.cspHashes.Styles = append(v.cspHashes.Styles, cspHash(contents))
 }
 
 // InitializeContext initializes context used to store internal state used during rendering.
@@ -516,6 +2035,254 @@ func getContext(ctx context.Context) (context.Context, *contextValue) {
 	return ctx, v
 }
 
+// Content Security Policy nonce handling.
+
+// WithNonce sets a Content Security Policy nonce on the context. The nonce is added to the
+// <script> and <style> elements that templ renders for script templates and CSS classes.
+func WithNonce(ctx context.Context, nonce string) context.Context {
+	ctx, v := getContext(ctx)
+	v.nonce = nonce
+	return ctx
+}
+
+// GetNonce returns the Content Security Policy nonce from the context, or an empty string if
+// no nonce has been set. It can be used to add the nonce to <script> and <style> elements
+// within templates, e.g. <script nonce={ templ.GetNonce(ctx) }>.
+func GetNonce(ctx context.Context) string {
+	_, v := getContext(ctx)
+	return v.nonce
+}
+
+func nonceAttribute(nonce string) string {
+	if nonce == "" {
+		return ""
+	}
+	return ` nonce="` + EscapeString(nonce) + `"`
+}
+
+// NewNonceMiddleware creates HTTP middleware that adds a new random Content Security Policy
+// nonce to the context of each request, and sets a Content-Security-Policy header that allows
+// scripts and styles that use the nonce, and the event handlers rendered by templ.
+func NewNonceMiddleware(next http.Handler) NonceMiddleware {
+	return NonceMiddleware{
+		Next:   next,
+		Policy: NoncePolicy,
+	}
+}
+
+// NoncePolicy returns a Content-Security-Policy that only allows <script> and <style>
+// elements that use the nonce. A nonce doesn't apply to attributes, so the event handlers that
+// call script templates, e.g. onclick={ onClick() }, and the style attributes rendered by templ
+// are allowed by their hashes.
+func NoncePolicy(nonce string, hashes CSPHashes) string {
+	source := "'nonce-" + nonce + "'"
+	return "script-src " + cspSources([]string{source}, hashes.ScriptAttributes) +
+		"; style-src " + cspSources([]string{source}, hashes.StyleAttributes)
+}
+
+// NonceMiddleware adds a Content Security Policy nonce to the context of each request.
+//
+// The Content-Security-Policy header is set when the response is first written, so that it
+// can contain the hashes of the event handlers that have been rendered. When streaming, event
+// handlers rendered after the first flush aren't included.
+type NonceMiddleware struct {
+	Next http.Handler
+	// Policy returns the value of the Content-Security-Policy header for the nonce, and the
+	// hashes of the inline scripts and styles rendered before the response is written.
+	// If nil, the header is not set.
+	Policy func(nonce string, hashes CSPHashes) string
+}
+
+func (nm NonceMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	nonce, err := newNonce()
+	if err != nil {
+		http.Error(w, "templ: failed to create nonce", http.StatusInternalServerError)
+		return
+	}
+	ctx := WithNonce(r.Context(), nonce)
+	if nm.Policy == nil {
+		nm.Next.ServeHTTP(w, r.WithContext(ctx))
+		return
+	}
+	ctx = WithCSPHashes(ctx)
+	pw := &policyResponseWriter{
+		ResponseWriter: w,
+		setPolicy: func() {
+			w.Header().Set("Content-Security-Policy", nm.Policy(nonce, GetCSPHashes(ctx)))
+		},
+	}
+	nm.Next.ServeHTTP(pw, r.WithContext(ctx))
+	// Set the header even if nothing was written.
+	pw.writePolicy()
+}
+
+// policyResponseWriter sets the Content-Security-Policy header just before the response is
+// first written.
+type policyResponseWriter struct {
+	http.ResponseWriter
+	setPolicy func()
+	written   bool
+}
+
+func (w *policyResponseWriter) writePolicy() {
+	if w.written {
+		return
+	}
+	w.written = true
+	w.setPolicy()
+}
+
+func (w *policyResponseWriter) WriteHeader(status int) {
+	w.writePolicy()
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *policyResponseWriter) Write(p []byte) (int, error) {
+	w.writePolicy()
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *policyResponseWriter) Flush() {
+	w.writePolicy()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying ResponseWriter, for use by http.ResponseController.
+func (w *policyResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func newNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// Content Security Policy hash handling.
+
+// CSPHashes are the Content Security Policy hashes of the inline scripts and styles rendered
+// by templ, e.g. 'sha256-...'.
+type CSPHashes struct {
+	// Scripts and Styles are the hashes of the contents of <script> and <style> elements.
+	Scripts []string
+	Styles  []string
+	// ScriptAttributes are the hashes of event handler attributes that call script templates,
+	// e.g. onclick={ onClick() }, and StyleAttributes are the hashes of style attributes.
+	// They're only allowed by policies that use 'unsafe-hashes'.
+	ScriptAttributes []string
+	StyleAttributes  []string
+}
+
+// cspSources returns a space separated list of the sources, followed by 'unsafe-hashes' and the
+// unique attribute hashes, if there are any.
+func cspSources(sources []string, attributeHashes []string) string {
+	if len(attributeHashes) > 0 {
+		sources = append(sources, "'unsafe-hashes'")
+		seen := make(map[string]struct{}, len(attributeHashes))
+		for _, h := range attributeHashes {
+			if _, ok := seen[h]; ok {
+				continue
+			}
+			seen[h] = struct{}{}
+			sources = append(sources, h)
+		}
+	}
+	return strings.Join(sources, " ")
+}
+
+// cspHash returns the Content Security Policy source expression of the SHA-256 hash of the
+// contents of an element, e.g. 'sha256-...'.
+func cspHash(contents string) string {
+	sum := sha256.Sum256([]byte(contents))
+	return "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+}
+
+// WithCSPHashes enables the collection of Content Security Policy hashes of the contents of
+// the <script> and <style> elements that templ renders for script templates and CSS classes,
+// of the event handler attributes that call script templates, and of style attributes.
+// Scripts and classes are only rendered once per context, so each element is only hashed once.
+func WithCSPHashes(ctx context.Context) context.Context {
+	ctx, v := getContext(ctx)
+	if v.cspHashes == nil {
+		v.cspHashes = &CSPHashes{}
+	}
+	return ctx
+}
+
+// GetCSPHashes returns the Content Security Policy hashes of the inline scripts and styles
+// rendered using the context, in the order they were rendered.
+// Hashes are only collected if the context was created using WithCSPHashes.
+func GetCSPHashes(ctx context.Context) CSPHashes {
+	_, v := getContext(ctx)
+	if v.cspHashes == nil {
+		return CSPHashes{}
+	}
+	return *v.cspHashes
+}
+
+// NewCSPMiddleware creates HTTP middleware that collects the hashes of the <script> and <style>
+// elements rendered by templ components, and sets a Content-Security-Policy header that allows
+// them. To be able to set the header after rendering, the response is buffered.
+func NewCSPMiddleware(next http.Handler) CSPMiddleware {
+	return CSPMiddleware{
+		Next:   next,
+		Policy: HashPolicy,
+	}
+}
+
+// HashPolicy returns a Content-Security-Policy that allows scripts and styles from the same
+// origin, and inline scripts, styles, event handlers and style attributes with the given hashes.
+func HashPolicy(hashes CSPHashes) string {
+	return "script-src " + cspSources(append([]string{"'self'"}, hashes.Scripts...), hashes.ScriptAttributes) +
+		"; style-src " + cspSources(append([]string{"'self'"}, hashes.Styles...), hashes.StyleAttributes)
+}
+
+// CSPMiddleware sets a Content-Security-Policy header containing the hashes of the
+// <script> and <style> elements that templ components render.
+type CSPMiddleware struct {
+	Next http.Handler
+	// Policy returns the value of the Content-Security-Policy header for the hashes.
+	Policy func(hashes CSPHashes) string
+}
+
+func (cspm CSPMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := WithCSPHashes(r.Context())
+	bw := &bufferedResponseWriter{
+		ResponseWriter: w,
+		body:           GetBuffer(),
+	}
+	defer ReleaseBuffer(bw.body)
+	cspm.Next.ServeHTTP(bw, r.WithContext(ctx))
+	w.Header().Set("Content-Security-Policy", cspm.Policy(GetCSPHashes(ctx)))
+	if bw.status != 0 {
+		w.WriteHeader(bw.status)
+	}
+	// Ignore write errors, the client has gone away.
+	_, _ = bw.body.WriteTo(w)
+}
+
+// bufferedResponseWriter holds back the status code and body of a response, so that headers can
+// be set once the response is complete.
+type bufferedResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   *bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *bufferedResponseWriter) Write(p []byte) (int, error) {
+	return w.body.Write(p)
+}
+
 // ComponentScript is a templ Script template.
 type ComponentScript struct {
 	// Name of the script, e.g. print.
@@ -527,21 +2294,191 @@ type ComponentScript struct {
 	Call string
 }
 
+// NewScriptMiddleware creates HTTP middleware that renders a global script of ComponentScript
+// functions if the request path matches, or updates the HTTP context to ensure that any handlers
+// that use templ.Components render a <script src> reference to the global script instead of
+// inline <script> elements for scripts that are included in it. By default, the script path is
+// /scripts/templ.js, and components reference the fingerprinted path returned by Src.
+func NewScriptMiddleware(next http.Handler, scripts ...ComponentScript) ScriptMiddleware {
+	return ScriptMiddleware{
+		Path:          "/scripts/templ.js",
+		ScriptHandler: NewScriptHandler(scripts...),
+		Next:          next,
+	}
+}
+
+// ScriptMiddleware renders a global script.
+type ScriptMiddleware struct {
+	Path          string
+	ScriptHandler ScriptHandler
+	Next          http.Handler
+}
+
+func (sm ScriptMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == sm.Path {
+		sm.ScriptHandler.ServeHTTP(w, r)
+		return
+	}
+	if r.URL.Path == sm.Src() {
+		// The content of the fingerprinted URL never changes, so it can be cached indefinitely.
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		sm.ScriptHandler.ServeHTTP(w, r)
+		return
+	}
+	// Add registered scripts to the context.
+	ctx, v := getContext(r.Context())
+	v.scriptSrc = sm.Src()
+	for _, s := range sm.ScriptHandler.Scripts {
+		v.addScript(s.Name)
+		v.addBundledScript(s.Name)
+	}
+	// Serve the request. Templ components will use the updated context
+	// to render a reference to the global script instead of inline <script>
+	// elements for any scripts that have been included in it.
+	sm.Next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Src returns the fingerprinted URL of the global script, e.g. /scripts/templ.<hash>.js
+// The URL changes when the functions change, so it can be cached by browsers indefinitely.
+func (sm ScriptMiddleware) Src() string {
+	ext := path.Ext(sm.Path)
+	return strings.TrimSuffix(sm.Path, ext) + "." + sm.ScriptHandler.Hash() + ext
+}
+
+// NewScriptHandler creates a handler that serves a script containing the functions of the
+// scripts passed in. This is used by the ScriptMiddleware to provide global scripts for
+// templ components.
+func NewScriptHandler(scripts ...ComponentScript) ScriptHandler {
+	sh := ScriptHandler{
+		Scripts: scripts,
+	}
+	sh.bundle = sh.render()
+	return sh
+}
+
+// ScriptHandler is a HTTP handler that serves JavaScript.
+type ScriptHandler struct {
+	Logger func(err error)
+	// CacheControl is the value of the Cache-Control header. If empty, browsers must
+	// check whether the script has changed before using a cached copy.
+	CacheControl string
+	Scripts      []ComponentScript
+	// bundle is the rendered script, computed once by NewScriptHandler.
+	bundle *scriptBundle
+}
+
+type scriptBundle struct {
+	content []byte
+	hash    string
+}
+
+func (sh ScriptHandler) render() *scriptBundle {
+	var b bytes.Buffer
+	seen := make(map[string]struct{}, len(sh.Scripts))
+	for _, s := range sh.Scripts {
+		if _, ok := seen[s.Name]; ok {
+			continue
+		}
+		seen[s.Name] = struct{}{}
+		b.WriteString(s.Function)
+	}
+	hash := sha256.Sum256(b.Bytes())
+	return &scriptBundle{
+		content: b.Bytes(),
+		hash:    hex.EncodeToString(hash[:8]),
+	}
+}
+
+func (sh ScriptHandler) getBundle() *scriptBundle {
+	if sh.bundle == nil {
+		return sh.render()
+	}
+	return sh.bundle
+}
+
+// Hash returns a hash of the content of the script.
+func (sh ScriptHandler) Hash() string {
+	return sh.getBundle().hash
+}
+
+func (sh ScriptHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sb := sh.getBundle()
+	etag := `"` + sb.hash + `"`
+	w.Header().Set("Content-Type", "text/javascript")
+	w.Header().Set("ETag", etag)
+	if w.Header().Get("Cache-Control") == "" {
+		cacheControl := sh.CacheControl
+		if cacheControl == "" {
+			// Function names change with their content, so a stale copy must not be used.
+			cacheControl = "no-cache"
+		}
+		w.Header().Set("Cache-Control", cacheControl)
+	}
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if _, err := w.Write(sb.content); err != nil && sh.Logger != nil {
+		sh.Logger(err)
+	}
+}
+
+// RenderScriptCall writes the call of a script template to an event handler attribute, e.g.
+// onclick={ onClick() }, and records the Content Security Policy hash of the attribute value,
+// if hashes are being collected. It's used by generated code.
+func RenderScriptCall(ctx context.Context, w io.Writer, s ComponentScript) (err error) {
+	_, v := getContext(ctx)
+	// Browsers hash the attribute value after decoding the HTML entities in it.
+	v.addScriptAttributeHash(html.UnescapeString(s.Call))
+	_, err = io.WriteString(w, s.Call)
+	return err
+}
+
+// RenderStyleAttribute writes the escaped value of a style attribute, and records its Content
+// Security Policy hash, if hashes are being collected. It's used by generated code.
+func RenderStyleAttribute(ctx context.Context, w io.Writer, value string) (err error) {
+	AddStyleAttributeHash(ctx, value)
+	_, err = io.WriteString(w, EscapeString(value))
+	return err
+}
+
+// AddStyleAttributeHash records the Content Security Policy hash of the value of a style
+// attribute, if hashes are being collected. It's used by generated code.
+func AddStyleAttributeHash(ctx context.Context, value string) {
+	if v, ok := ctx.Value(contextKey).(*contextValue); ok {
+		v.addStyleAttributeHash(value)
+	}
+}
+
 // RenderScriptItems renders a <script> element, if the script has not already been rendered.
+// If any of the scripts are included in the global script provided by the ScriptMiddleware,
+// a <script src> reference to the global script is rendered instead, once per render.
 func RenderScriptItems(ctx context.Context, w io.Writer, scripts ...ComponentScript) (err error) {
 	if len(scripts) == 0 {
 		return nil
 	}
 	_, v := getContext(ctx)
+	if v.isOutputDiscarded() {
+		// Render the scripts within the fragments instead.
+		return nil
+	}
 	sb := new(strings.Builder)
 	for _, s := range scripts {
+		if v.scriptSrc != "" && v.isScriptBundled(s.Name) && !v.has(scriptSrcKey) {
+			if _, err = io.WriteString(w, `<script type="text/javascript" src="`+EscapeString(v.scriptSrc)+`"`+nonceAttribute(v.nonce)+`></script>`); err != nil {
+				return err
+			}
+			// Only reference the global script once.
+			v.add(scriptSrcKey)
+		}
 		if !v.hasScriptBeenRendered(s.Name) {
 			sb.WriteString(s.Function)
 			v.addScript(s.Name)
 		}
 	}
 	if sb.Len() > 0 {
-		if _, err = io.WriteString(w, `<script type="text/javascript">`); err != nil {
+		v.addScriptHash(sb.String())
+		if _, err = io.WriteString(w, `<script type="text/javascript"`+nonceAttribute(v.nonce)+`>`); err != nil {
 			return err
 		}
 		if _, err = io.WriteString(w, sb.String()); err != nil {
@@ -2,12 +2,19 @@ package templ_test
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/a-h/templ"
 	"github.com/google/go-cmp/cmp"
@@ -117,6 +124,121 @@ func TestCSSMiddleware(t *testing.T) {
 	}
 }
 
+func TestCSSHandlerCaching(t *testing.T) {
+	c1 := templ.ComponentCSSClass{
+		ID:    "c1",
+		Class: ".c1{color:red}",
+	}
+	h := templ.NewCSSHandler(c1)
+	etag := `"` + h.Hash() + `"`
+
+	t.Run("the hash changes when the CSS changes", func(t *testing.T) {
+		c2 := templ.ComponentCSSClass{
+			ID:    "c2",
+			Class: ".c2{color:blue}",
+		}
+		if h.Hash() == templ.NewCSSHandler(c1, c2).Hash() {
+			t.Error("expected the hash to change")
+		}
+		if h.Hash() != templ.NewCSSHandler(c1).Hash() {
+			t.Error("expected the hash to be stable")
+		}
+	})
+	t.Run("responses include an ETag", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", "/styles/templ.css", nil))
+		if diff := cmp.Diff(etag, w.Header().Get("ETag")); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff("no-cache", w.Header().Get("Cache-Control")); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(".c1{color:red}", w.Body.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("requests with a matching If-None-Match header receive a 304", func(t *testing.T) {
+		for _, ifNoneMatch := range []string{etag, "W/" + etag, `"other", ` + etag, "*"} {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "/styles/templ.css", nil)
+			r.Header.Set("If-None-Match", ifNoneMatch)
+			h.ServeHTTP(w, r)
+			if w.Code != http.StatusNotModified {
+				t.Errorf("%s: expected status %d, got %d", ifNoneMatch, http.StatusNotModified, w.Code)
+			}
+			if w.Body.Len() != 0 {
+				t.Errorf("%s: expected empty body, got %q", ifNoneMatch, w.Body.String())
+			}
+		}
+	})
+	t.Run("requests with a different If-None-Match header receive the stylesheet", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/styles/templ.css", nil)
+		r.Header.Set("If-None-Match", `"other"`)
+		h.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+		if diff := cmp.Diff(".c1{color:red}", w.Body.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("compressed stylesheets are served to clients that accept them", func(t *testing.T) {
+		h := h.WithCompression(templ.GzipCSSCompression)
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/styles/templ.css", nil)
+		r.Header.Set("Accept-Encoding", "br;q=1.0, gzip;q=0.8")
+		h.ServeHTTP(w, r)
+		if diff := cmp.Diff("gzip", w.Header().Get("Content-Encoding")); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff("Accept-Encoding", w.Header().Get("Vary")); diff != "" {
+			t.Error(diff)
+		}
+		gr, err := gzip.NewReader(w.Body)
+		if err != nil {
+			t.Fatalf("failed to create gzip reader: %v", err)
+		}
+		body, err := io.ReadAll(gr)
+		if err != nil {
+			t.Fatalf("failed to read gzip body: %v", err)
+		}
+		if diff := cmp.Diff(".c1{color:red}", string(body)); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("uncompressed stylesheets are served to clients that don't accept compression", func(t *testing.T) {
+		h := h.WithCompression(templ.GzipCSSCompression)
+		for _, acceptEncoding := range []string{"", "br", "gzip;q=0"} {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest("GET", "/styles/templ.css", nil)
+			r.Header.Set("Accept-Encoding", acceptEncoding)
+			h.ServeHTTP(w, r)
+			if diff := cmp.Diff("", w.Header().Get("Content-Encoding")); diff != "" {
+				t.Errorf("%s: %s", acceptEncoding, diff)
+			}
+			if diff := cmp.Diff(".c1{color:red}", w.Body.String()); diff != "" {
+				t.Errorf("%s: %s", acceptEncoding, diff)
+			}
+		}
+	})
+	t.Run("the middleware serves the fingerprinted URL with long-lived caching", func(t *testing.T) {
+		cssmw := templ.NewCSSMiddleware(templ.Handler(templ.NopComponent), c1)
+		expectedHref := "/styles/templ." + h.Hash() + ".css"
+		if diff := cmp.Diff(expectedHref, cssmw.Href()); diff != "" {
+			t.Error(diff)
+		}
+		w := httptest.NewRecorder()
+		cssmw.ServeHTTP(w, httptest.NewRequest("GET", cssmw.Href(), nil))
+		if diff := cmp.Diff("public, max-age=31536000, immutable", w.Header().Get("Cache-Control")); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(".c1{color:red}", w.Body.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
 func TestRenderCSS(t *testing.T) {
 	c1 := templ.ComponentCSSClass{
 		ID:    "c1",
@@ -382,30 +504,61 @@ func TestHandler(t *testing.T) {
 	errorComponent := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
 		return errors.New("handler error")
 	})
+	partialErrorComponent := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if _, err := io.WriteString(w, "<div>partial"); err != nil {
+			t.Fatalf("failed to write string: %v", err)
+		}
+		return errors.New("handler error")
+	})
 
 	tests := []struct {
-		name           string
-		input          *templ.ComponentHandler
-		expectedStatus int
-		expectedBody   string
+		name                string
+		input               *templ.ComponentHandler
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
 	}{
 		{
-			name:           "handlers return OK by default",
-			input:          templ.Handler(hello),
-			expectedStatus: http.StatusOK,
-			expectedBody:   "Hello",
+			name:                "handlers return OK by default",
+			input:               templ.Handler(hello),
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/html",
+			expectedBody:        "Hello",
 		},
 		{
-			name:           "handlers can be configured to return an alternative status code",
-			input:          templ.Handler(hello, templ.WithStatus(http.StatusNotFound)),
-			expectedStatus: http.StatusNotFound,
-			expectedBody:   "Hello",
+			name:                "handlers can be configured to return an alternative status code",
+			input:               templ.Handler(hello, templ.WithStatus(http.StatusNotFound)),
+			expectedStatus:      http.StatusNotFound,
+			expectedContentType: "text/html",
+			expectedBody:        "Hello",
+		},
+		{
+			name:                "handlers can be configured to return an alternative content type",
+			input:               templ.Handler(hello, templ.WithContentType("text/plain")),
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/plain",
+			expectedBody:        "Hello",
+		},
+		{
+			name:                "handlers that fail return a 500 error",
+			input:               templ.Handler(errorComponent),
+			expectedStatus:      http.StatusInternalServerError,
+			expectedContentType: "text/plain; charset=utf-8",
+			expectedBody:        "templ: failed to render template\n",
+		},
+		{
+			name:                "handlers that fail part way through rendering don't return partial output",
+			input:               templ.Handler(partialErrorComponent, templ.WithStatus(http.StatusNotFound)),
+			expectedStatus:      http.StatusInternalServerError,
+			expectedContentType: "text/plain; charset=utf-8",
+			expectedBody:        "templ: failed to render template\n",
 		},
 		{
-			name:           "handlers that fail return a 500 error",
-			input:          templ.Handler(errorComponent),
-			expectedStatus: http.StatusInternalServerError,
-			expectedBody:   "templ: failed to render template\n",
+			name:                "streaming handlers that fail part way through rendering append the error",
+			input:               templ.Handler(partialErrorComponent, templ.WithStreaming()),
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/html",
+			expectedBody:        "<div>partialtempl: failed to render template\n",
 		},
 		{
 			name: "error handling can be customised",
@@ -419,8 +572,24 @@ func TestHandler(t *testing.T) {
 					}
 				})
 			})),
-			expectedStatus: http.StatusBadRequest,
-			expectedBody:   "custom body",
+			expectedStatus:      http.StatusBadRequest,
+			expectedContentType: "",
+			expectedBody:        "custom body",
+		},
+		{
+			name: "error handlers receive a clean response writer",
+			input: templ.Handler(partialErrorComponent, templ.WithErrorHandler(func(r *http.Request, err error) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "text/plain")
+					w.WriteHeader(http.StatusBadRequest)
+					if _, err := io.WriteString(w, "custom body"); err != nil {
+						t.Fatalf("failed to write string: %v", err)
+					}
+				})
+			})),
+			expectedStatus:      http.StatusBadRequest,
+			expectedContentType: "text/plain",
+			expectedBody:        "custom body",
 		},
 	}
 	for _, tt := range tests {
@@ -432,6 +601,9 @@ func TestHandler(t *testing.T) {
 			if got := w.Result().StatusCode; tt.expectedStatus != got {
 				t.Errorf("expected status %d, got %d", tt.expectedStatus, got)
 			}
+			if diff := cmp.Diff(tt.expectedContentType, w.Result().Header.Get("Content-Type")); diff != "" {
+				t.Error(diff)
+			}
 			body, err := io.ReadAll(w.Result().Body)
 			if err != nil {
 				t.Errorf("failed to read body: %v", err)
@@ -442,3 +614,1488 @@ func TestHandler(t *testing.T) {
 		})
 	}
 }
+
+type urlString string
+
+func TestRenderAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    templ.Attributes
+		expected string
+	}{
+		{
+			name:     "no attributes render nothing",
+			input:    nil,
+			expected: ``,
+		},
+		{
+			name: "attributes are rendered in name order",
+			input: templ.Attributes{
+				"hx-get":  "/items",
+				"data-id": "123",
+			},
+			expected: ` data-id="123" hx-get="/items"`,
+		},
+		{
+			name: "true bool values are rendered as bare attributes, false values are omitted",
+			input: templ.Attributes{
+				"disabled":    true,
+				"aria-hidden": false,
+			},
+			expected: ` disabled`,
+		},
+		{
+			name: "values are escaped",
+			input: templ.Attributes{
+				"data-name": `"><script>alert(1)</script>`,
+			},
+			expected: ` data-name="&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;"`,
+		},
+		{
+			name: "invalid names are not rendered",
+			input: templ.Attributes{
+				`data-"name"`:                "a",
+				"x onmouseover=alert(1) y":   "z",
+				"x=y":                        true,
+				"":                           "empty",
+				"data-valid_name:with.chars": "b",
+			},
+			expected: ` data-valid_name:with.chars="b"`,
+		},
+		{
+			name: "event handler attributes are not rendered",
+			input: templ.Attributes{
+				"onclick":              "alert(1)",
+				"ONMOUSEOVER":          "alert(2)",
+				"hx-on:click":          "alert(3)",
+				"hx-on-click":          "alert(4)",
+				"hx-on--after-request": "alert(5)",
+				"data-hx-on:click":     "alert(6)",
+				"data-hx-on--load":     "alert(7)",
+				"hx-on":                "click: alert(8)",
+				"data-onclick":         "value",
+			},
+			expected: ` data-onclick="value"`,
+		},
+		{
+			name: "URL attributes are sanitized, unless they're a templ.SafeURL",
+			input: templ.Attributes{
+				"href":   "javascript:alert(1)",
+				"src":    templ.SafeURL("javascript:alert(2)"),
+				"srcset": "/a.png 1x, javascript:alert(3) 2x",
+			},
+			expected: ` href="about:invalid#TemplFailedSanitizationURL" src="javascript:alert(2)" srcset="/a.png 1x, about:invalid#TemplFailedSanitizationURL 2x"`,
+		},
+		{
+			name: "URL attributes of any element are sanitized",
+			input: templ.Attributes{
+				"background":  "javascript:alert(1)",
+				"data":        "javascript:alert(2)",
+				"imagesrcset": "/a.png 1x, javascript:alert(3) 2x",
+				"ping":        "javascript:alert(4)",
+			},
+			expected: ` background="about:invalid#TemplFailedSanitizationURL" data="about:invalid#TemplFailedSanitizationURL" imagesrcset="/a.png 1x, about:invalid#TemplFailedSanitizationURL 2x" ping="about:invalid#TemplFailedSanitizationURL"`,
+		},
+		{
+			name: "URL attributes of other types are formatted, then sanitized",
+			input: templ.Attributes{
+				"href":   urlString("javascript:alert(1)"),
+				"src":    &url.URL{Scheme: "javascript", Opaque: "alert(2)"},
+				"srcset": urlString("/a.png 1x, javascript:alert(3) 2x"),
+			},
+			expected: ` href="about:invalid#TemplFailedSanitizationURL" src="about:invalid#TemplFailedSanitizationURL" srcset="/a.png 1x, about:invalid#TemplFailedSanitizationURL 2x"`,
+		},
+		{
+			name: "other values are formatted",
+			input: templ.Attributes{
+				"tabindex": 1,
+				"nothing":  nil,
+			},
+			expected: ` tabindex="1"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			err := templ.RenderAttributes(context.Background(), b, tt.input)
+			if err != nil {
+				t.Fatalf("failed to render attributes: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestHandlerStreaming(t *testing.T) {
+	// Templates render into a buffer, and write it to the output once rendering is complete.
+	// The page records what the client had received at the point of the flush.
+	page := func(w *httptest.ResponseRecorder, receivedBeforeEnd *string) templ.Component {
+		return templ.ComponentFunc(func(ctx context.Context, rw io.Writer) (err error) {
+			b := new(bytes.Buffer)
+			b.WriteString("<head></head>")
+			if err = templ.Flush().Render(ctx, b); err != nil {
+				return err
+			}
+			*receivedBeforeEnd = w.Body.String()
+			b.WriteString("<body></body>")
+			_, err = b.WriteTo(rw)
+			return err
+		})
+	}
+
+	tests := []struct {
+		name                      string
+		options                   []func(*templ.ComponentHandler)
+		expectedFlushed           bool
+		expectedReceivedBeforeEnd string
+	}{
+		{
+			name:                      "without streaming, flush has no effect",
+			expectedFlushed:           false,
+			expectedReceivedBeforeEnd: "",
+		},
+		{
+			name:                      "with streaming, output before the flush is written to the client",
+			options:                   []func(*templ.ComponentHandler){templ.WithStreaming()},
+			expectedFlushed:           true,
+			expectedReceivedBeforeEnd: "<head></head>",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			var receivedBeforeEnd string
+			templ.Handler(page(w, &receivedBeforeEnd), tt.options...).ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+			if w.Flushed != tt.expectedFlushed {
+				t.Errorf("expected flushed to be %v, got %v", tt.expectedFlushed, w.Flushed)
+			}
+			if diff := cmp.Diff(tt.expectedReceivedBeforeEnd, receivedBeforeEnd); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff("<head></head><body></body>", w.Body.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestIsURLAttribute(t *testing.T) {
+	tests := []struct {
+		element        string
+		name           string
+		expectedIsURL  bool
+		expectedIsList bool
+	}{
+		{element: "a", name: "href", expectedIsURL: true},
+		{element: "div", name: "href", expectedIsURL: true},
+		{element: "form", name: "action", expectedIsURL: true},
+		{element: "div", name: "action"},
+		{element: "A", name: "PING", expectedIsURL: true},
+		{element: "img", name: "srcset", expectedIsURL: true, expectedIsList: true},
+		{element: "link", name: "imagesrcset", expectedIsURL: true, expectedIsList: true},
+		{element: "img", name: "imagesrcset"},
+		{element: "div", name: "title"},
+		{element: "", name: "data", expectedIsURL: true},
+		{element: "", name: "srcset", expectedIsURL: true, expectedIsList: true},
+		{element: "", name: "title"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.element+" "+tt.name, func(t *testing.T) {
+			isURL, isList := templ.IsURLAttribute(tt.element, tt.name)
+			if isURL != tt.expectedIsURL || isList != tt.expectedIsList {
+				t.Errorf("expected (%v, %v), got (%v, %v)", tt.expectedIsURL, tt.expectedIsList, isURL, isList)
+			}
+		})
+	}
+}
+
+func TestSanitizeURLAttribute(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    templ.SafeURL
+		expected templ.SafeURL
+	}{
+		{
+			name:     "relative URLs are allowed",
+			input:    templ.SanitizeURLAttribute("/images/cat.png"),
+			expected: "/images/cat.png",
+		},
+		{
+			name:     "https URLs are allowed",
+			input:    templ.SanitizeURLAttribute("https://example.com"),
+			expected: "https://example.com",
+		},
+		{
+			name:     "javascript URLs are sanitized",
+			input:    templ.SanitizeURLAttribute("javascript:alert(1)"),
+			expected: templ.FailedSanitizationURL,
+		},
+		{
+			name:     "safe URLs are not sanitized",
+			input:    templ.SanitizeURLAttribute(templ.SafeURL("javascript:alert(1)")),
+			expected: "javascript:alert(1)",
+		},
+		{
+			name:     "each srcset candidate is sanitized",
+			input:    templ.SanitizeSrcsetAttribute("/small.png 1x, JavaScript:alert(1) 2x,/large.png 480w"),
+			expected: "/small.png 1x, about:invalid#TemplFailedSanitizationURL 2x,/large.png 480w",
+		},
+		{
+			name:     "srcset candidates without descriptors are sanitized",
+			input:    templ.SanitizeSrcsetAttribute("javascript:alert(1)"),
+			expected: templ.FailedSanitizationURL,
+		},
+		{
+			name:     "empty srcset candidates are ignored",
+			input:    templ.SanitizeSrcsetAttribute("/small.png 1x,,"),
+			expected: "/small.png 1x,,",
+		},
+		{
+			name:     "safe srcset values are not sanitized",
+			input:    templ.SanitizeSrcsetAttribute(templ.SafeURL("javascript:alert(1) 2x")),
+			expected: "javascript:alert(1) 2x",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, tt.input); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestNonce(t *testing.T) {
+	script := templ.ComponentScript{
+		Name:     "s1",
+		Function: "function s1(){}",
+	}
+	class := templ.ComponentCSSClass{
+		ID:    "c1",
+		Class: ".c1{color:red}",
+	}
+	tests := []struct {
+		name     string
+		nonce    string
+		expected string
+	}{
+		{
+			name:     "without a nonce, elements are rendered without a nonce attribute",
+			expected: `<script type="text/javascript">function s1(){}</script><style type="text/css">.c1{color:red}</style>`,
+		},
+		{
+			name:     "with a nonce, elements are rendered with a nonce attribute",
+			nonce:    "abc123",
+			expected: `<script type="text/javascript" nonce="abc123">function s1(){}</script><style type="text/css" nonce="abc123">.c1{color:red}</style>`,
+		},
+		{
+			name:     "nonces are escaped",
+			nonce:    `"><script>`,
+			expected: `<script type="text/javascript" nonce="&#34;&gt;&lt;script&gt;">function s1(){}</script><style type="text/css" nonce="&#34;&gt;&lt;script&gt;">.c1{color:red}</style>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := templ.WithNonce(context.Background(), tt.nonce)
+			b := new(bytes.Buffer)
+			if err := templ.RenderScriptItems(ctx, b, script); err != nil {
+				t.Fatalf("failed to render script: %v", err)
+			}
+			if err := templ.RenderCSSItems(ctx, b, class); err != nil {
+				t.Fatalf("failed to render CSS: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestNonceMiddleware(t *testing.T) {
+	var nonce string
+	pageHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nonce = templ.GetNonce(r.Context())
+	})
+	handler := templ.ComponentScript{
+		Name:     "s1",
+		Function: "function s1(){}",
+		Call:     templ.SafeScript("s1"),
+	}
+	handlerPage := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		// Like generated code, the output is buffered until the page has been rendered.
+		b := new(bytes.Buffer)
+		if err := templ.RenderScriptItems(ctx, b, handler); err != nil {
+			return err
+		}
+		for i := 0; i < 2; i++ {
+			b.WriteString(`<button onclick="`)
+			if err := templ.RenderScriptCall(ctx, b, handler); err != nil {
+				return err
+			}
+			b.WriteString(`"></button>`)
+		}
+		_, err := b.WriteTo(w)
+		return err
+	})
+	sum := sha256.Sum256([]byte("s1()"))
+	handlerHash := "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+
+	t.Run("a nonce is added to the context, and to the policy", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		templ.NewNonceMiddleware(pageHandler).ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		if nonce == "" {
+			t.Fatal("expected a nonce to be set")
+		}
+		expectedPolicy := "script-src 'nonce-" + nonce + "'; style-src 'nonce-" + nonce + "'"
+		if diff := cmp.Diff(expectedPolicy, w.Header().Get("Content-Security-Policy")); diff != "" {
+			t.Error(diff)
+		}
+	})
+	for _, streaming := range []bool{false, true} {
+		streaming := streaming
+		t.Run(fmt.Sprintf("event handlers rendered before the response is written are allowed by their hashes, streaming %v", streaming), func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h := templ.Handler(handlerPage)
+			h.Streaming = streaming
+			templ.NewNonceMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				nonce = templ.GetNonce(r.Context())
+				h.ServeHTTP(w, r)
+			})).ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+			expectedPolicy := "script-src 'nonce-" + nonce + "' 'unsafe-hashes' " + handlerHash + "; style-src 'nonce-" + nonce + "'"
+			if diff := cmp.Diff(expectedPolicy, w.Header().Get("Content-Security-Policy")); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("each request gets a different nonce", func(t *testing.T) {
+		h := templ.NewNonceMiddleware(pageHandler)
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+		first := nonce
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+		if first == nonce {
+			t.Errorf("expected different nonces, got %q twice", nonce)
+		}
+	})
+	t.Run("the policy header is not set if the policy is nil", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h := templ.NewNonceMiddleware(pageHandler)
+		h.Policy = nil
+		h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		if csp := w.Header().Get("Content-Security-Policy"); csp != "" {
+			t.Errorf("expected no policy, got %q", csp)
+		}
+	})
+}
+
+func TestCSPHashes(t *testing.T) {
+	s1 := templ.ComponentScript{
+		Name:     "s1",
+		Function: "function s1(){}",
+	}
+	s2 := templ.ComponentScript{
+		Name:     "s2",
+		Function: "function s2(){}",
+	}
+	c1 := templ.ComponentCSSClass{
+		ID:    "c1",
+		Class: ".c1{color:red}",
+	}
+	hash := func(s string) string {
+		sum := sha256.Sum256([]byte(s))
+		return "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+	}
+
+	t.Run("hashes are not collected by default", func(t *testing.T) {
+		ctx := templ.InitializeContext(context.Background())
+		if err := templ.RenderScriptItems(ctx, io.Discard, s1); err != nil {
+			t.Fatalf("failed to render script: %v", err)
+		}
+		if diff := cmp.Diff(templ.CSPHashes{}, templ.GetCSPHashes(ctx)); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("the contents of each element is hashed, and duplicate scripts are only hashed once", func(t *testing.T) {
+		ctx := templ.WithCSPHashes(context.Background())
+		b := new(bytes.Buffer)
+		for _, scripts := range [][]templ.ComponentScript{{s1, s2}, {s1}, {s2}} {
+			if err := templ.RenderScriptItems(ctx, b, scripts...); err != nil {
+				t.Fatalf("failed to render script: %v", err)
+			}
+		}
+		for i := 0; i < 2; i++ {
+			if err := templ.RenderCSSItems(ctx, b, c1); err != nil {
+				t.Fatalf("failed to render CSS: %v", err)
+			}
+		}
+		expectedOutput := `<script type="text/javascript">function s1(){}function s2(){}</script><style type="text/css">.c1{color:red}</style>`
+		if diff := cmp.Diff(expectedOutput, b.String()); diff != "" {
+			t.Error(diff)
+		}
+		expectedHashes := templ.CSPHashes{
+			Scripts: []string{hash("function s1(){}function s2(){}")},
+			Styles:  []string{hash(".c1{color:red}")},
+		}
+		if diff := cmp.Diff(expectedHashes, templ.GetCSPHashes(ctx)); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("style attributes are hashed", func(t *testing.T) {
+		ctx := templ.WithCSPHashes(context.Background())
+		b := new(bytes.Buffer)
+		if err := templ.RenderStyleAttribute(ctx, b, "font-family: 'a'"); err != nil {
+			t.Fatalf("failed to render style attribute: %v", err)
+		}
+		if err := templ.RenderAttributes(ctx, b, templ.Attributes{"style": "color: red"}); err != nil {
+			t.Fatalf("failed to render attributes: %v", err)
+		}
+		if diff := cmp.Diff(`font-family: &#39;a&#39; style="color: red"`, b.String()); diff != "" {
+			t.Error(diff)
+		}
+		expectedHashes := templ.CSPHashes{
+			StyleAttributes: []string{hash("font-family: 'a'"), hash("color: red")},
+		}
+		if diff := cmp.Diff(expectedHashes, templ.GetCSPHashes(ctx)); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("the decoded values of event handler attributes are hashed", func(t *testing.T) {
+		ctx := templ.WithCSPHashes(context.Background())
+		b := new(bytes.Buffer)
+		s := templ.ComponentScript{
+			Name:     "s1",
+			Function: "function s1(a){}",
+			Call:     templ.SafeScript("s1", "a"),
+		}
+		if err := templ.RenderScriptCall(ctx, b, s); err != nil {
+			t.Fatalf("failed to render script call: %v", err)
+		}
+		if diff := cmp.Diff(`s1(&#34;a&#34;)`, b.String()); diff != "" {
+			t.Error(diff)
+		}
+		expectedHashes := templ.CSPHashes{
+			ScriptAttributes: []string{hash(`s1("a")`)},
+		}
+		if diff := cmp.Diff(expectedHashes, templ.GetCSPHashes(ctx)); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestCSPMiddleware(t *testing.T) {
+	s1 := templ.ComponentScript{
+		Name:     "s1",
+		Function: "function s1(){}",
+	}
+	page := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if err := templ.RenderScriptItems(ctx, w, s1); err != nil {
+			return err
+		}
+		return templ.RenderScriptItems(ctx, w, s1)
+	})
+	sum := sha256.Sum256([]byte(s1.Function))
+	s1Hash := "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+	sum = sha256.Sum256([]byte("s1()"))
+	handlerHash := "'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'"
+
+	tests := []struct {
+		name           string
+		handler        http.Handler
+		expectedStatus int
+		expectedPolicy string
+		expectedBody   string
+	}{
+		{
+			name:           "the policy contains the hashes of the rendered elements",
+			handler:        templ.NewCSPMiddleware(templ.Handler(page)),
+			expectedStatus: http.StatusOK,
+			expectedPolicy: "script-src 'self' " + s1Hash + "; style-src 'self'",
+			expectedBody:   `<script type="text/javascript">function s1(){}</script>`,
+		},
+		{
+			name:           "the status code is passed through",
+			handler:        templ.NewCSPMiddleware(templ.Handler(page, templ.WithStatus(http.StatusNotFound))),
+			expectedStatus: http.StatusNotFound,
+			expectedPolicy: "script-src 'self' " + s1Hash + "; style-src 'self'",
+			expectedBody:   `<script type="text/javascript">function s1(){}</script>`,
+		},
+		{
+			name: "the policy contains the hashes of event handlers",
+			handler: templ.NewCSPMiddleware(templ.Handler(templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+				return templ.RenderScriptCall(ctx, w, templ.ComponentScript{Name: "s1", Call: "s1()"})
+			}))),
+			expectedStatus: http.StatusOK,
+			expectedPolicy: "script-src 'self' 'unsafe-hashes' " + handlerHash + "; style-src 'self'",
+			expectedBody:   `s1()`,
+		},
+		{
+			name:           "handlers that don't render scripts get a policy without hashes",
+			handler:        templ.NewCSPMiddleware(templ.Handler(templ.NopComponent)),
+			expectedStatus: http.StatusOK,
+			expectedPolicy: "script-src 'self'; style-src 'self'",
+			expectedBody:   ``,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			tt.handler.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+			if got := w.Result().StatusCode; tt.expectedStatus != got {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, got)
+			}
+			if diff := cmp.Diff(tt.expectedPolicy, w.Result().Header.Get("Content-Security-Policy")); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(tt.expectedBody, w.Body.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestScriptMiddleware(t *testing.T) {
+	s1 := templ.ComponentScript{
+		Name:     "s1",
+		Function: "function s1(){}",
+	}
+	s2 := templ.ComponentScript{
+		Name:     "s2",
+		Function: "function s2(){}",
+	}
+	page := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if err := templ.RenderScriptItems(ctx, w, s1, s2); err != nil {
+			return err
+		}
+		return templ.RenderScriptItems(ctx, w, s1, s2)
+	})
+	src := func(scripts ...templ.ComponentScript) string {
+		return templ.NewScriptMiddleware(nil, scripts...).Src()
+	}
+	withETag := func(r *http.Request, etag string) *http.Request {
+		r.Header.Set("If-None-Match", etag)
+		return r
+	}
+
+	tests := []struct {
+		name                 string
+		input                *http.Request
+		handler              http.Handler
+		nonce                string
+		expectedMIMEType     string
+		expectedCacheControl string
+		expectedStatus       int
+		expectedBody         string
+	}{
+		{
+			name:                 "accessing /scripts/templ.js renders JavaScript, even if it's empty",
+			input:                httptest.NewRequest("GET", "/scripts/templ.js", nil),
+			handler:              templ.NewScriptMiddleware(templ.Handler(page)),
+			expectedMIMEType:     "text/javascript",
+			expectedCacheControl: "no-cache",
+			expectedBody:         "",
+		},
+		{
+			name:                 "accessing /scripts/templ.js renders JavaScript that includes the scripts once",
+			input:                httptest.NewRequest("GET", "/scripts/templ.js", nil),
+			handler:              templ.NewScriptMiddleware(templ.Handler(page), s1, s2, s1),
+			expectedMIMEType:     "text/javascript",
+			expectedCacheControl: "no-cache",
+			expectedBody:         "function s1(){}function s2(){}",
+		},
+		{
+			name:                 "the fingerprinted path can be cached indefinitely",
+			input:                httptest.NewRequest("GET", src(s1, s2), nil),
+			handler:              templ.NewScriptMiddleware(templ.Handler(page), s1, s2),
+			expectedMIMEType:     "text/javascript",
+			expectedCacheControl: "public, max-age=31536000, immutable",
+			expectedBody:         "function s1(){}function s2(){}",
+		},
+		{
+			name:                 "the global script is not sent again if the ETag matches",
+			input:                withETag(httptest.NewRequest("GET", "/scripts/templ.js", nil), `"`+templ.NewScriptHandler(s1, s2).Hash()+`"`),
+			handler:              templ.NewScriptMiddleware(templ.Handler(page), s1, s2),
+			expectedMIMEType:     "text/javascript",
+			expectedCacheControl: "no-cache",
+			expectedStatus:       http.StatusNotModified,
+			expectedBody:         "",
+		},
+		{
+			name:             "scripts that aren't included in the global script are rendered inline",
+			input:            httptest.NewRequest("GET", "/index.html", nil),
+			handler:          templ.NewScriptMiddleware(templ.Handler(page)),
+			expectedMIMEType: "text/html",
+			expectedBody:     `<script type="text/javascript">function s1(){}function s2(){}</script>`,
+		},
+		{
+			name:             "scripts that are included in the global script are referenced once",
+			input:            httptest.NewRequest("GET", "/index.html", nil),
+			handler:          templ.NewScriptMiddleware(templ.Handler(page), s1, s2),
+			expectedMIMEType: "text/html",
+			expectedBody:     `<script type="text/javascript" src="` + src(s1, s2) + `"></script>`,
+		},
+		{
+			name:             "a mix of included and inline scripts are rendered",
+			input:            httptest.NewRequest("GET", "/index.html", nil),
+			handler:          templ.NewScriptMiddleware(templ.Handler(page), s2),
+			expectedMIMEType: "text/html",
+			expectedBody:     `<script type="text/javascript" src="` + src(s2) + `"></script><script type="text/javascript">function s1(){}</script>`,
+		},
+		{
+			name:             "the global script reference includes the nonce",
+			input:            httptest.NewRequest("GET", "/index.html", nil),
+			handler:          templ.NewScriptMiddleware(templ.Handler(page), s1, s2),
+			nonce:            "abc",
+			expectedMIMEType: "text/html",
+			expectedBody:     `<script type="text/javascript" src="` + src(s1, s2) + `" nonce="abc"></script>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := tt.input
+			if tt.nonce != "" {
+				r = r.WithContext(templ.WithNonce(r.Context(), tt.nonce))
+			}
+			tt.handler.ServeHTTP(w, r)
+			if diff := cmp.Diff(tt.expectedMIMEType, w.Header().Get("Content-Type")); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(tt.expectedCacheControl, w.Header().Get("Cache-Control")); diff != "" {
+				t.Error(diff)
+			}
+			if tt.expectedStatus != 0 && tt.expectedStatus != w.Code {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, w.Code)
+			}
+			if diff := cmp.Diff(tt.expectedBody, w.Body.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	errFailed := errors.New("failed")
+	tests := []struct {
+		name     string
+		input    error
+		expected string
+	}{
+		{
+			name:     "the position of the failing node is included",
+			input:    &templ.Error{Err: errFailed, Name: "Card", FileName: "card.templ", Line: 12, Col: 5},
+			expected: "Card at card.templ:12:5: failed",
+		},
+		{
+			name:     "the file name is omitted if unknown",
+			input:    &templ.Error{Err: errFailed, Name: "Card", Line: 12, Col: 5},
+			expected: "Card at line 12, col 5: failed",
+		},
+		{
+			name:     "the line is omitted if unknown",
+			input:    &templ.Error{Err: errFailed, Name: "Card", FileName: "card.templ"},
+			expected: "Card at card.templ: failed",
+		},
+		{
+			name: "nested errors build a chain of template names, with the position of the innermost template",
+			input: &templ.Error{Name: "Page", FileName: "page.templ", Line: 3, Col: 2,
+				Err: &templ.Error{Name: "Layout", FileName: "layout.templ", Line: 7, Col: 4,
+					Err: &templ.Error{Err: errFailed, Name: "Card", FileName: "card.templ", Line: 12, Col: 5},
+				},
+			},
+			expected: "Page > Layout > Card at card.templ:12:5: failed",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.expected, tt.input.Error()); diff != "" {
+				t.Error(diff)
+			}
+			if !errors.Is(tt.input, errFailed) {
+				t.Error("expected errors.Is to find the underlying error")
+			}
+		})
+	}
+}
+
+func TestAsync(t *testing.T) {
+	text := func(s string) templ.Component {
+		return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			_, err := io.WriteString(w, s)
+			return err
+		})
+	}
+	resolved := func(c templ.Component) func(ctx context.Context) (templ.Component, error) {
+		return func(ctx context.Context) (templ.Component, error) {
+			return c, nil
+		}
+	}
+	join := func(components ...templ.Component) templ.Component {
+		return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			for _, c := range components {
+				if err := c.Render(ctx, w); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	swap := func(id string) string {
+		return `<script type="text/javascript">(function(){var p=document.getElementById("` + id + `"),t=document.getElementById("` + id + `-content");if(p&&t){p.replaceWith(t.content);t.remove()}})()</script>`
+	}
+
+	t.Run("outside of a handler, the resolved component is rendered in place of the placeholder", func(t *testing.T) {
+		page := join(text("<p>"), templ.Async(text("Loading..."), resolved(text("Loaded"))), text("</p>"))
+		b := new(bytes.Buffer)
+		if err := page.Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if diff := cmp.Diff("<p>Loaded</p>", b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("handlers render placeholders, then the resolved components in the order that they're resolved", func(t *testing.T) {
+		secondRendered := make(chan struct{})
+		first := templ.Async(text("Loading 1"), func(ctx context.Context) (templ.Component, error) {
+			// Wait until the second component has been rendered.
+			<-secondRendered
+			return text("Loaded 1"), nil
+		})
+		second := templ.Async(text("Loading 2"), resolved(templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			close(secondRendered)
+			_, err := io.WriteString(w, "Loaded 2")
+			return err
+		})))
+		page := join(text("<main>"), first, second, text("</main>"))
+		for _, streaming := range []bool{false, true} {
+			w := httptest.NewRecorder()
+			h := templ.Handler(page)
+			h.Streaming = streaming
+			secondRendered = make(chan struct{})
+			h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+			if w.Code != http.StatusOK {
+				t.Errorf("streaming %v: expected status %d, got %d", streaming, http.StatusOK, w.Code)
+			}
+			expected := `<main><templ-async id="templ-async-1">Loading 1</templ-async><templ-async id="templ-async-2">Loading 2</templ-async></main>` +
+				`<template id="templ-async-2-content">Loaded 2</template>` + swap("templ-async-2") +
+				`<template id="templ-async-1-content">Loaded 1</template>` + swap("templ-async-1")
+			if diff := cmp.Diff(expected, w.Body.String()); diff != "" {
+				t.Errorf("streaming %v: %s", streaming, diff)
+			}
+		}
+	})
+	t.Run("resolve errors are returned by buffered handlers", func(t *testing.T) {
+		page := templ.Async(text("Loading"), func(ctx context.Context) (templ.Component, error) {
+			return nil, errors.New("resolve failed")
+		})
+		w := httptest.NewRecorder()
+		templ.Handler(page).ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
+		}
+	})
+	t.Run("resolve is passed a copy of the render state", func(t *testing.T) {
+		class := templ.ComponentCSSClass{ID: "c1", Class: ".c1{color:red}"}
+		style := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			return templ.RenderCSSItems(ctx, w, class)
+		})
+		resolving := make(chan struct{})
+		page := join(templ.Async(text("Loading"), func(ctx context.Context) (templ.Component, error) {
+			defer close(resolving)
+			// Rendering with the context doesn't affect the page.
+			b := new(bytes.Buffer)
+			if err := style.Render(ctx, b); err != nil {
+				return nil, err
+			}
+			if templ.GetNonce(ctx) != "abc" {
+				return nil, errors.New("expected the nonce to be copied")
+			}
+			return text(b.String()), nil
+		}), templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			<-resolving
+			return style.Render(ctx, w)
+		}))
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/", nil)
+		r = r.WithContext(templ.WithNonce(r.Context(), "abc"))
+		templ.Handler(page).ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		expectedStyle := `<style type="text/css" nonce="abc">.c1{color:red}</style>`
+		if n := strings.Count(w.Body.String(), expectedStyle); n != 2 {
+			t.Errorf("expected the style to be rendered by the page and the resolved component, got %d in %q", n, w.Body.String())
+		}
+	})
+	t.Run("the swap script includes the nonce", func(t *testing.T) {
+		page := templ.Async(text("Loading"), resolved(text("Loaded")))
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("GET", "/", nil)
+		r = r.WithContext(templ.WithNonce(r.Context(), "abc"))
+		templ.Handler(page).ServeHTTP(w, r)
+		if !strings.Contains(w.Body.String(), `<script type="text/javascript" nonce="abc">`) {
+			t.Errorf("expected the nonce in the swap script, got %q", w.Body.String())
+		}
+	})
+}
+
+func TestMemoryCache(t *testing.T) {
+	entry := func(s string) templ.CacheEntry {
+		return templ.CacheEntry{Output: []byte(s)}
+	}
+	get := func(c templ.Cache, key string) string {
+		e, ok := c.Get(key)
+		if !ok {
+			return "<missing>"
+		}
+		return string(e.Output)
+	}
+	t.Run("entries can be retrieved", func(t *testing.T) {
+		c := templ.NewMemoryCache(2)
+		c.Set("a", entry("A"), 0)
+		if actual := get(c, "a"); actual != "A" {
+			t.Errorf("expected %q, got %q", "A", actual)
+		}
+		c.Set("a", entry("B"), 0)
+		if actual := get(c, "a"); actual != "B" {
+			t.Errorf("expected the replaced value %q, got %q", "B", actual)
+		}
+	})
+	t.Run("the least recently used entry is evicted when the cache is full", func(t *testing.T) {
+		c := templ.NewMemoryCache(2)
+		c.Set("a", entry("A"), 0)
+		c.Set("b", entry("B"), 0)
+		// Use a, so that b is the least recently used.
+		get(c, "a")
+		c.Set("c", entry("C"), 0)
+		if actual := get(c, "b"); actual != "<missing>" {
+			t.Errorf("expected b to be evicted, got %q", actual)
+		}
+		if actual := get(c, "a"); actual != "A" {
+			t.Errorf("expected %q, got %q", "A", actual)
+		}
+		if actual := get(c, "c"); actual != "C" {
+			t.Errorf("expected %q, got %q", "C", actual)
+		}
+	})
+	t.Run("entries expire after the ttl", func(t *testing.T) {
+		c := templ.NewMemoryCache(2)
+		c.Set("a", entry("A"), time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+		if actual := get(c, "a"); actual != "<missing>" {
+			t.Errorf("expected a to have expired, got %q", actual)
+		}
+	})
+}
+
+func TestCached(t *testing.T) {
+	class := templ.ComponentCSSClass{
+		ID:    "c1",
+		Class: ".c1{color:red}",
+	}
+	var renders int
+	component := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		renders++
+		if err := templ.RenderCSSItems(ctx, w, class); err != nil {
+			return err
+		}
+		_, err := io.WriteString(w, `<div class="c1">`+templ.GetNonce(ctx)+`</div>`)
+		return err
+	})
+	render := func(ctx context.Context, components ...templ.Component) string {
+		ctx = templ.InitializeContext(ctx)
+		b := new(bytes.Buffer)
+		for _, c := range components {
+			if err := c.Render(ctx, b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+		}
+		return b.String()
+	}
+	setup := func() {
+		renders = 0
+		templ.DefaultCache = templ.NewMemoryCache(8)
+	}
+
+	t.Run("the output is rendered once, and then read from the cache", func(t *testing.T) {
+		setup()
+		cached := templ.Cached("key", 0, component)
+		expected := `<style type="text/css">.c1{color:red}</style><div class="c1"></div>`
+		for i := 0; i < 3; i++ {
+			if diff := cmp.Diff(expected, render(context.Background(), cached)); diff != "" {
+				t.Error(diff)
+			}
+		}
+		if renders != 1 {
+			t.Errorf("expected 1 render, got %d", renders)
+		}
+	})
+	t.Run("CSS in the cached output is registered, so it's not rendered again", func(t *testing.T) {
+		setup()
+		cached := templ.Cached("key", 0, component)
+		render(context.Background(), cached)
+		actual := render(context.Background(), cached, templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			return templ.RenderCSSItems(ctx, w, class)
+		}))
+		expected := `<style type="text/css">.c1{color:red}</style><div class="c1"></div>`
+		if diff := cmp.Diff(expected, actual); diff != "" {
+			t.Error(diff)
+		}
+		if renders != 1 {
+			t.Errorf("expected 1 render, got %d", renders)
+		}
+	})
+	t.Run("cached output that would render CSS again is not used", func(t *testing.T) {
+		setup()
+		cached := templ.Cached("key", 0, component)
+		render(context.Background(), cached)
+		actual := render(context.Background(), cached, cached)
+		expected := `<style type="text/css">.c1{color:red}</style><div class="c1"></div><div class="c1"></div>`
+		if diff := cmp.Diff(expected, actual); diff != "" {
+			t.Error(diff)
+		}
+		if renders != 2 {
+			t.Errorf("expected 2 renders, got %d", renders)
+		}
+	})
+	t.Run("the nonce of the current render is used", func(t *testing.T) {
+		setup()
+		cached := templ.Cached("key", 0, component)
+		render(templ.WithNonce(context.Background(), "first"), cached)
+		actual := render(templ.WithNonce(context.Background(), "second"), cached)
+		expected := `<style type="text/css" nonce="second">.c1{color:red}</style><div class="c1">second</div>`
+		if diff := cmp.Diff(expected, actual); diff != "" {
+			t.Error(diff)
+		}
+		if renders != 1 {
+			t.Errorf("expected 1 render, got %d", renders)
+		}
+	})
+	t.Run("render errors are not cached", func(t *testing.T) {
+		setup()
+		failing := templ.Cached("key", 0, templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			renders++
+			return errors.New("failed")
+		}))
+		for i := 0; i < 2; i++ {
+			if err := failing.Render(context.Background(), io.Discard); err == nil {
+				t.Error("expected an error")
+			}
+		}
+		if renders != 2 {
+			t.Errorf("expected 2 renders, got %d", renders)
+		}
+	})
+	t.Run("the cache set on the context is used instead of the DefaultCache", func(t *testing.T) {
+		setup()
+		cached := templ.Cached("key", 0, component)
+		a, b := templ.NewMemoryCache(8), templ.NewMemoryCache(8)
+		render(templ.WithCache(context.Background(), a), cached)
+		render(templ.WithCache(context.Background(), a), cached)
+		if renders != 1 {
+			t.Errorf("expected 1 render, got %d", renders)
+		}
+		render(templ.WithCache(context.Background(), b), cached)
+		if renders != 2 {
+			t.Errorf("expected each cache to be rendered into once, got %d renders", renders)
+		}
+		if _, ok := templ.DefaultCache.Get("key"); ok {
+			t.Error("expected the DefaultCache not to be used")
+		}
+	})
+	templ.DefaultCache = templ.NewMemoryCache(1024)
+}
+
+func TestJSONScript(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		value    any
+		expected string
+	}{
+		{
+			name:     "values are encoded as JSON",
+			id:       "data",
+			value:    map[string]any{"name": "Alice", "age": 42},
+			expected: `<script type="application/json" id="data">{"age":42,"name":"Alice"}</script>`,
+		},
+		{
+			name:     "closing script tags are escaped",
+			id:       "data",
+			value:    "</script><script>alert(1)</script>",
+			expected: `<script type="application/json" id="data">"\u003c/script\u003e\u003cscript\u003ealert(1)\u003c/script\u003e"</script>`,
+		},
+		{
+			name:     "closing script tags are escaped regardless of case",
+			id:       "data",
+			value:    "</SCRIPT >",
+			expected: `<script type="application/json" id="data">"\u003c/SCRIPT \u003e"</script>`,
+		},
+		{
+			name:     "comments are escaped",
+			id:       "data",
+			value:    "<!--<script>",
+			expected: `<script type="application/json" id="data">"\u003c!--\u003cscript\u003e"</script>`,
+		},
+		{
+			name:     "line terminators are escaped",
+			id:       "data",
+			value:    "a\u2028b\u2029c",
+			expected: `<script type="application/json" id="data">"a\u2028b\u2029c"</script>`,
+		},
+		{
+			name:     "ampersands are escaped",
+			id:       "data",
+			value:    "&lt;",
+			expected: `<script type="application/json" id="data">"\u0026lt;"</script>`,
+		},
+		{
+			name:     "ids are escaped",
+			id:       `"><script>alert(1)</script>`,
+			value:    1,
+			expected: `<script type="application/json" id="&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;">1</script>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.JSONScript(tt.id, tt.value).Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("values that can't be encoded return an error", func(t *testing.T) {
+		err := templ.JSONScript("data", make(chan int)).Render(context.Background(), io.Discard)
+		if err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestJSONString(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{
+			name:     "values are encoded as JSON",
+			value:    []int{1, 2, 3},
+			expected: `[1,2,3]`,
+		},
+		{
+			name:     "quotes can't break out of the attribute once escaped",
+			value:    `"><script>alert(1)</script>`,
+			expected: `&#34;\&#34;\u003e\u003cscript\u003ealert(1)\u003c/script\u003e&#34;`,
+		},
+		{
+			name:     "single quotes are escaped",
+			value:    `' onload='alert(1)`,
+			expected: `&#34;&#39; onload=&#39;alert(1)&#34;`,
+		},
+		{
+			name:     "values that can't be encoded are empty",
+			value:    make(chan int),
+			expected: ``,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// Attribute values are escaped by templ when they're rendered.
+			actual := templ.EscapeString(templ.JSONString(tt.value))
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestErrorBoundary(t *testing.T) {
+	class := templ.ComponentCSSClass{
+		ID:    "c1",
+		Class: ".c1{color:red}",
+	}
+	text := func(s string) templ.Component {
+		return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			_, err := io.WriteString(w, s)
+			return err
+		})
+	}
+	errFailed := errors.New("failed")
+	failing := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if err := templ.RenderCSSItems(ctx, w, class); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, "partial"); err != nil {
+			return err
+		}
+		return errFailed
+	})
+	fallback := func(err error) templ.Component {
+		return text("fallback: " + err.Error())
+	}
+
+	tests := []struct {
+		name     string
+		input    templ.Component
+		expected string
+	}{
+		{
+			name:     "children that render successfully are written to the output",
+			input:    templ.ErrorBoundary(text("ok"), fallback),
+			expected: "ok",
+		},
+		{
+			name:     "if the child returns an error, its output is discarded, and the fallback is rendered",
+			input:    templ.ErrorBoundary(failing, fallback),
+			expected: "fallback: failed",
+		},
+		{
+			name: "if the child panics, the fallback is rendered",
+			input: templ.ErrorBoundary(templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+				panic("oops")
+			}), fallback),
+			expected: "fallback: templ: render panicked: oops",
+		},
+		{
+			name:     "if the fallback is nil, nothing is rendered",
+			input:    templ.ErrorBoundary(failing, nil),
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := tt.input.Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("errors are logged", func(t *testing.T) {
+		var logged error
+		c := templ.ErrorBoundary(failing, fallback).WithLogger(func(err error) {
+			logged = err
+		})
+		if err := c.Render(context.Background(), io.Discard); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if !errors.Is(logged, errFailed) {
+			t.Errorf("expected the error to be logged, got %v", logged)
+		}
+	})
+	t.Run("CSS registered by the failed child is rendered again by later components", func(t *testing.T) {
+		ctx := templ.InitializeContext(context.Background())
+		b := new(bytes.Buffer)
+		if err := templ.ErrorBoundary(failing, nil).Render(ctx, b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if err := templ.RenderCSSItems(ctx, b, class); err != nil {
+			t.Fatalf("failed to render CSS: %v", err)
+		}
+		expected := `<style type="text/css">.c1{color:red}</style>`
+		if diff := cmp.Diff(expected, b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("CSS registered by a successful child is not rendered again", func(t *testing.T) {
+		ctx := templ.InitializeContext(context.Background())
+		b := new(bytes.Buffer)
+		child := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			return templ.RenderCSSItems(ctx, w, class)
+		})
+		if err := templ.ErrorBoundary(child, nil).Render(ctx, b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if err := templ.RenderCSSItems(ctx, b, class); err != nil {
+			t.Fatalf("failed to render CSS: %v", err)
+		}
+		expected := `<style type="text/css">.c1{color:red}</style>`
+		if diff := cmp.Diff(expected, b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("partial output of the child is not flushed when streaming", func(t *testing.T) {
+		child := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			if _, err := io.WriteString(w, "partial"); err != nil {
+				return err
+			}
+			if err := templ.Flush().Render(ctx, w); err != nil {
+				return err
+			}
+			return errFailed
+		})
+		h := templ.Handler(templ.ErrorBoundary(child, fallback))
+		h.Streaming = true
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		if diff := cmp.Diff("fallback: failed", w.Body.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestHead(t *testing.T) {
+	text := func(s string) templ.Component {
+		return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			_, err := io.WriteString(w, s)
+			return err
+		})
+	}
+	head := func(key, content string) templ.Component {
+		return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			return templ.Head(key).Render(templ.WithChildren(ctx, text(content)), w)
+		})
+	}
+	join := func(components ...templ.Component) templ.Component {
+		return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			for _, c := range components {
+				if err := c.Render(ctx, w); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	page := func(body ...templ.Component) templ.Component {
+		return join(text("<head>"), templ.HeadOutlet(), text("</head><body>"), join(body...), text("</body>"))
+	}
+
+	tests := []struct {
+		name     string
+		input    templ.Component
+		expected string
+	}{
+		{
+			name:     "without head content, the outlet is removed",
+			input:    page(text("x")),
+			expected: `<head></head><body>x</body>`,
+		},
+		{
+			name:     "head content is added to the outlet",
+			input:    page(head("title", "<title>A</title>"), text("x")),
+			expected: `<head><title>A</title></head><body>x</body>`,
+		},
+		{
+			name:     "the last content for each key is used, in the position of the first",
+			input:    page(head("title", "<title>A</title>"), head("meta", "<meta>"), head("title", "<title>B</title>")),
+			expected: `<head><title>B</title><meta></head><body></body>`,
+		},
+		{
+			name:     "content without a key is always added",
+			input:    page(head("", "<link>"), head("", "<link>")),
+			expected: `<head><link><link></head><body></body>`,
+		},
+		{
+			name:     "head content from failed error boundaries is discarded",
+			input:    page(head("title", "<title>A</title>"), templ.ErrorBoundary(join(head("title", "<title>B</title>"), templ.ComponentFunc(func(ctx context.Context, w io.Writer) error { return errors.New("failed") })), nil)),
+			expected: `<head><title>A</title></head><body></body>`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.RenderWithHead(context.Background(), b, tt.input); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("without a head collector, head content is rendered in place and the outlet is removed", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		if err := page(head("title", "<title>A</title>"), text("x")).Render(context.Background(), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if diff := cmp.Diff(`<head></head><body><title>A</title>x</body>`, b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("head content from cached components is added on cache hits", func(t *testing.T) {
+		templ.DefaultCache = templ.NewMemoryCache(8)
+		defer func() {
+			templ.DefaultCache = templ.NewMemoryCache(1024)
+		}()
+		cached := templ.Cached("key", 0, join(head("title", "<title>A</title>"), text("x")))
+		for i := 0; i < 2; i++ {
+			b := new(bytes.Buffer)
+			if err := templ.RenderWithHead(context.Background(), b, page(cached)); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(`<head><title>A</title></head><body>x</body>`, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		}
+	})
+	t.Run("when streaming, head content rendered after the outlet has been sent is rendered in place", func(t *testing.T) {
+		// Generated templates render into a buffer, that's written out by Flush.
+		buffered := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			b := new(bytes.Buffer)
+			if err := page(head("title", "<title>A</title>"), templ.Flush(), head("meta", "<meta>")).Render(ctx, b); err != nil {
+				return err
+			}
+			_, err := b.WriteTo(w)
+			return err
+		})
+		h := templ.Handler(buffered)
+		h.Streaming = true
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+		if diff := cmp.Diff(`<head><title>A</title></head><body><meta></body>`, w.Body.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	withoutOutlet := join(text("<head></head><body>"), head("title", "<title>A</title>"), text("x</body>"))
+	for _, streaming := range []bool{false, true} {
+		streaming := streaming
+		t.Run(fmt.Sprintf("when rendered by a handler without an outlet, head content is rendered in place (streaming: %v)", streaming), func(t *testing.T) {
+			h := templ.Handler(withoutOutlet)
+			h.Streaming = streaming
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+			if diff := cmp.Diff(`<head></head><body><title>A</title>x</body>`, w.Body.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+	t.Run("head content from cached components is rendered in place on cache hits without an outlet", func(t *testing.T) {
+		templ.DefaultCache = templ.NewMemoryCache(8)
+		defer func() {
+			templ.DefaultCache = templ.NewMemoryCache(1024)
+		}()
+		cached := templ.Cached("key", 0, join(head("title", "<title>A</title>"), text("x")))
+		for i := 0; i < 2; i++ {
+			for _, render := range []func(b *bytes.Buffer) error{
+				func(b *bytes.Buffer) error { return templ.RenderWithHead(context.Background(), b, cached) },
+				func(b *bytes.Buffer) error { return cached.Render(context.Background(), b) },
+			} {
+				b := new(bytes.Buffer)
+				if err := render(b); err != nil {
+					t.Fatalf("failed to render: %v", err)
+				}
+				if diff := cmp.Diff(`<title>A</title>x`, b.String()); diff != "" {
+					t.Error(diff)
+				}
+			}
+		}
+	})
+}
+
+func TestOnce(t *testing.T) {
+	once := func(key, content string) templ.Component {
+		children := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			_, err := io.WriteString(w, content)
+			return err
+		})
+		return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			return templ.Once(key).Render(templ.WithChildren(ctx, children), w)
+		})
+	}
+	t.Run("children are rendered the first time that each key is rendered", func(t *testing.T) {
+		ctx := templ.InitializeContext(context.Background())
+		b := new(bytes.Buffer)
+		for _, c := range []templ.Component{once("a", "A1"), once("b", "B1"), once("a", "A2"), once("b", "B2")} {
+			if err := c.Render(ctx, b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+		}
+		if diff := cmp.Diff("A1B1", b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("each render context renders the children", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			b := new(bytes.Buffer)
+			if err := once("a", "A").Render(context.Background(), b); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff("A", b.String()); diff != "" {
+				t.Error(diff)
+			}
+		}
+	})
+	t.Run("keys don't clash with CSS classes", func(t *testing.T) {
+		ctx := templ.InitializeContext(context.Background())
+		b := new(bytes.Buffer)
+		if err := templ.RenderCSSItems(ctx, b, templ.ComponentCSSClass{ID: "a", Class: ".a{}"}); err != nil {
+			t.Fatalf("failed to render CSS: %v", err)
+		}
+		if err := once("a", "A").Render(ctx, b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if diff := cmp.Diff(`<style type="text/css">.a{}</style>A`, b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+}
+
+func TestSlots(t *testing.T) {
+	text := func(s string) templ.Component {
+		return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			_, err := io.WriteString(w, s)
+			return err
+		})
+	}
+	// card renders its slots in the same way as a generated template.
+	card := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		children := templ.GetChildren(ctx)
+		slots := templ.GetSlots(ctx)
+		ctx = templ.ClearChildren(ctx)
+		for _, c := range []templ.Component{slots.Get("header", text("Untitled")), children, slots.Get("footer")} {
+			if err := c.Render(ctx, w); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	t.Run("slots are rendered by name", func(t *testing.T) {
+		ctx := templ.InitializeContext(context.Background())
+		ctx = templ.WithSlot(templ.WithSlot(templ.WithChildren(ctx, text("[body]")), "header", text("[header]")), "footer", text("[footer]"))
+		b := new(bytes.Buffer)
+		if err := card.Render(ctx, b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if diff := cmp.Diff("[header][body][footer]", b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("the fallback is rendered if a slot isn't passed", func(t *testing.T) {
+		b := new(bytes.Buffer)
+		if err := card.Render(templ.WithChildren(context.Background(), text("[body]")), b); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+		if diff := cmp.Diff("Untitled[body]", b.String()); diff != "" {
+			t.Error(diff)
+		}
+	})
+	t.Run("slots aren't passed to nested templates", func(t *testing.T) {
+		nested := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+			if len(templ.GetSlots(ctx)) > 0 {
+				t.Error("expected the slots to have been cleared")
+			}
+			return nil
+		})
+		ctx := templ.WithSlot(templ.WithChildren(context.Background(), nested), "header", nested)
+		if err := card.Render(ctx, io.Discard); err != nil {
+			t.Fatalf("failed to render: %v", err)
+		}
+	})
+}
@@ -0,0 +1,270 @@
+package templ
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// RenderText renders the component, and writes its output to w as readable plain text, e.g.
+// for the text part of an email, or search indexing. Block elements are written on new lines,
+// links are written as "text (url)", list items are written with bullets, and the contents of
+// elements that aren't displayed, such as <script> and <style>, are dropped.
+func RenderText(ctx context.Context, w io.Writer, c Component) (err error) {
+	b := GetBuffer()
+	defer ReleaseBuffer(b)
+	if err = c.Render(ctx, b); err != nil {
+		return err
+	}
+	return writeText(w, b.Bytes())
+}
+
+// writeText converts the HTML to plain text, and writes it to w.
+func writeText(w io.Writer, b []byte) (err error) {
+	tw := &textWriter{}
+	z := html.NewTokenizer(bytes.NewReader(b))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if err = z.Err(); err != io.EOF {
+				return err
+			}
+			_, err = w.Write(tw.bytes())
+			return err
+		case html.TextToken:
+			if tw.skip == 0 {
+				tw.text(string(z.Text()))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			attrs := map[string]string{}
+			for hasAttr {
+				var k, v []byte
+				k, v, hasAttr = z.TagAttr()
+				attrs[string(k)] = string(v)
+			}
+			tw.start(atom.Lookup(name), attrs, tt == html.SelfClosingTagToken)
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			tw.end(atom.Lookup(name))
+		}
+	}
+}
+
+// textWriter builds plain text from a stream of HTML tokens.
+type textWriter struct {
+	out []byte
+	// skip is the depth of elements whose contents aren't text, e.g. <script>.
+	skip int
+	// pre is the depth of <pre> elements, where whitespace is preserved.
+	pre int
+	// space is true if whitespace should be written before the next text.
+	space bool
+	// newlines is the number of line breaks to write before the next text.
+	newlines int
+	// prefix is written at the start of the next line, e.g. a list bullet.
+	prefix string
+	lists  []textList
+	links  []textLink
+}
+
+type textList struct {
+	ordered bool
+	index   int
+}
+
+type textLink struct {
+	href  string
+	start int
+}
+
+// paragraphElements are separated from surrounding text by a blank line.
+var paragraphElements = map[atom.Atom]bool{
+	atom.P: true, atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true,
+	atom.H6: true, atom.Blockquote: true, atom.Pre: true, atom.Table: true, atom.Hr: true,
+	atom.Dl: true, atom.Figure: true,
+}
+
+// blockElements start on a new line.
+var blockElements = map[atom.Atom]bool{
+	atom.Address: true, atom.Article: true, atom.Aside: true, atom.Body: true, atom.Dd: true,
+	atom.Details: true, atom.Dialog: true, atom.Div: true, atom.Dt: true, atom.Fieldset: true,
+	atom.Figcaption: true, atom.Footer: true, atom.Form: true, atom.Header: true, atom.Html: true,
+	atom.Li: true, atom.Main: true, atom.Nav: true, atom.Ol: true, atom.Section: true,
+	atom.Summary: true, atom.Tr: true, atom.Ul: true,
+}
+
+// skippedElements contain content that isn't displayed as text.
+var skippedElements = map[atom.Atom]bool{
+	atom.Head: true, atom.Script: true, atom.Style: true, atom.Template: true, atom.Noscript: true,
+	atom.Svg: true, atom.Select: true, atom.Iframe: true, atom.Object: true,
+}
+
+func (tw *textWriter) start(a atom.Atom, attrs map[string]string, selfClosing bool) {
+	if skippedElements[a] {
+		if !selfClosing {
+			tw.skip++
+		}
+		return
+	}
+	if tw.skip > 0 {
+		return
+	}
+	switch {
+	case paragraphElements[a]:
+		tw.lineBreak(2)
+	case blockElements[a]:
+		tw.lineBreak(1)
+	}
+	switch a {
+	case atom.Br:
+		tw.newlines++
+		tw.space = false
+	case atom.Hr:
+		tw.text("---")
+		tw.lineBreak(2)
+	case atom.Pre:
+		tw.pre++
+	case atom.Ul, atom.Ol:
+		tw.lists = append(tw.lists, textList{ordered: a == atom.Ol})
+	case atom.Li:
+		indent := 0
+		list := textList{}
+		if len(tw.lists) > 0 {
+			indent = len(tw.lists) - 1
+			tw.lists[len(tw.lists)-1].index++
+			list = tw.lists[len(tw.lists)-1]
+		}
+		bullet := "- "
+		if list.ordered {
+			bullet = strconv.Itoa(list.index) + ". "
+		}
+		tw.prefix = strings.Repeat("  ", indent) + bullet
+	case atom.Td, atom.Th:
+		tw.space = true
+	case atom.Img:
+		if alt := strings.TrimSpace(attrs["alt"]); alt != "" {
+			tw.text(alt)
+		}
+	case atom.A:
+		tw.links = append(tw.links, textLink{href: strings.TrimSpace(attrs["href"]), start: len(tw.out)})
+	}
+}
+
+func (tw *textWriter) end(a atom.Atom) {
+	if skippedElements[a] {
+		if tw.skip > 0 {
+			tw.skip--
+		}
+		return
+	}
+	if tw.skip > 0 {
+		return
+	}
+	switch a {
+	case atom.Pre:
+		if tw.pre > 0 {
+			tw.pre--
+		}
+	case atom.Ul, atom.Ol:
+		if len(tw.lists) > 0 {
+			tw.lists = tw.lists[:len(tw.lists)-1]
+		}
+	case atom.A:
+		if len(tw.links) > 0 {
+			link := tw.links[len(tw.links)-1]
+			tw.links = tw.links[:len(tw.links)-1]
+			tw.linkURL(link)
+		}
+	}
+	switch {
+	case paragraphElements[a]:
+		tw.lineBreak(2)
+	case blockElements[a]:
+		tw.lineBreak(1)
+	}
+}
+
+// linkURL writes the URL of the link after its text, if it's not the same as the text. URLs
+// that failed sanitization aren't written.
+func (tw *textWriter) linkURL(link textLink) {
+	if link.href == "" || strings.HasPrefix(link.href, "#") || strings.HasPrefix(strings.ToLower(link.href), "javascript:") {
+		return
+	}
+	if link.href == string(FailedSanitizationURL) {
+		return
+	}
+	text := ""
+	if link.start <= len(tw.out) {
+		text = strings.TrimSpace(string(tw.out[link.start:]))
+	}
+	if text == link.href || text == strings.TrimSpace(strings.TrimPrefix(link.href, "mailto:")) {
+		return
+	}
+	if text == "" {
+		tw.text(link.href)
+		return
+	}
+	tw.space = true
+	tw.text("(" + link.href + ")")
+}
+
+func (tw *textWriter) lineBreak(n int) {
+	if n > tw.newlines {
+		tw.newlines = n
+	}
+	tw.space = false
+}
+
+func (tw *textWriter) text(s string) {
+	if tw.pre > 0 {
+		tw.write(s)
+		return
+	}
+	if strings.TrimSpace(s) == "" {
+		if s != "" {
+			tw.space = true
+		}
+		return
+	}
+	if isSpace(s[0]) {
+		tw.space = true
+	}
+	tw.write(strings.Join(strings.Fields(s), " "))
+	tw.space = isSpace(s[len(s)-1])
+}
+
+// write writes s, preceded by any pending line breaks, prefix, or space.
+func (tw *textWriter) write(s string) {
+	if len(tw.out) > 0 && tw.newlines > 0 {
+		tw.out = append(tw.out, strings.Repeat("\n", tw.newlines)...)
+	}
+	if len(tw.out) > 0 && tw.newlines == 0 && tw.space && tw.prefix == "" {
+		tw.out = append(tw.out, ' ')
+	}
+	tw.out = append(tw.out, tw.prefix...)
+	tw.out = append(tw.out, s...)
+	tw.newlines, tw.space, tw.prefix = 0, false, ""
+}
+
+func (tw *textWriter) bytes() []byte {
+	if len(tw.out) == 0 {
+		return nil
+	}
+	// Remove trailing whitespace from each line.
+	lines := strings.Split(string(tw.out), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return []byte(strings.TrimSpace(strings.Join(lines, "\n")) + "\n")
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
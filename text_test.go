@@ -0,0 +1,179 @@
+package templ_test
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/google/go-cmp/cmp"
+)
+
+var updateText = flag.Bool("update-text", false, "update the expected.txt files of the generator tests")
+
+func htmlComponent(s string) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, s)
+		return err
+	})
+}
+
+func TestRenderText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "text is written as is",
+			input:    `Hello, world`,
+			expected: "Hello, world\n",
+		},
+		{
+			name:     "entities are decoded",
+			input:    `Fish &amp; chips &lt;3`,
+			expected: "Fish & chips <3\n",
+		},
+		{
+			name:     "whitespace is collapsed",
+			input:    "<span>  a \n\t b </span> <span>c</span>",
+			expected: "a b c\n",
+		},
+		{
+			name:     "block elements start on a new line",
+			input:    `<div>a</div><div>b</div><span>c</span>`,
+			expected: "a\nb\nc\n",
+		},
+		{
+			name:     "paragraphs and headings are separated by a blank line",
+			input:    `<h1>Title</h1><p>First</p><p>Second</p>`,
+			expected: "Title\n\nFirst\n\nSecond\n",
+		},
+		{
+			name:     "line breaks are preserved",
+			input:    `a<br>b<br/>c`,
+			expected: "a\nb\nc\n",
+		},
+		{
+			name:     "links are written with their URL",
+			input:    `<p>See <a href="https://example.com/docs">the docs</a>.</p>`,
+			expected: "See the docs (https://example.com/docs).\n",
+		},
+		{
+			name:     "links with the URL as their text are written once",
+			input:    `<a href="https://example.com">https://example.com</a> <a href="mailto:a@example.com">a@example.com</a>`,
+			expected: "https://example.com a@example.com\n",
+		},
+		{
+			name:     "links to fragments and scripts are written without the URL",
+			input:    `<a href="#top">Top</a> <a href="javascript:void(0)">Menu</a>`,
+			expected: "Top Menu\n",
+		},
+		{
+			name:     "links that failed sanitization are written without the URL",
+			input:    `<a href="about:invalid#TemplFailedSanitizationURL">Sanitized</a>`,
+			expected: "Sanitized\n",
+		},
+		{
+			name:     "unordered lists have bullets",
+			input:    `<ul><li>a</li><li>b</li></ul>`,
+			expected: "- a\n- b\n",
+		},
+		{
+			name:     "ordered lists are numbered",
+			input:    `<ol><li>a</li><li>b</li></ol>`,
+			expected: "1. a\n2. b\n",
+		},
+		{
+			name:     "nested lists are indented",
+			input:    `<ul><li>a<ol><li>b</li><li>c</li></ol></li><li>d</li></ul>`,
+			expected: "- a\n  1. b\n  2. c\n- d\n",
+		},
+		{
+			name:     "scripts, styles and the head are dropped",
+			input:    `<html><head><title>Title</title><style>p{}</style></head><body><script>alert(1)</script><p>Body</p></body></html>`,
+			expected: "Body\n",
+		},
+		{
+			name:     "images are written as their alt text",
+			input:    `<p><img src="logo.png" alt="Logo"> Company</p>`,
+			expected: "Logo Company\n",
+		},
+		{
+			name:     "table rows are written on new lines",
+			input:    `<table><tr><th>Name</th><th>Age</th></tr><tr><td>Alice</td><td>42</td></tr></table>`,
+			expected: "Name Age\nAlice 42\n",
+		},
+		{
+			name:     "whitespace in preformatted text is preserved",
+			input:    "<p>Code:</p><pre>a\n  b</pre>",
+			expected: "Code:\n\na\n  b\n",
+		},
+		{
+			name:     "horizontal rules are written as a line",
+			input:    `<p>a</p><hr><p>b</p>`,
+			expected: "a\n\n---\n\nb\n",
+		},
+		{
+			name:     "empty output is empty",
+			input:    `<script>alert(1)</script>`,
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(bytes.Buffer)
+			if err := templ.RenderText(context.Background(), b, htmlComponent(tt.input)); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if diff := cmp.Diff(tt.expected, b.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+// TestRenderTextGenerator converts the expected output of each generator test to text, and
+// compares it to the expected.txt file in the same directory. Run the test with -update-text to
+// update the expected.txt files.
+func TestRenderTextGenerator(t *testing.T) {
+	files, err := filepath.Glob("generator/test-*/expected.html")
+	if err != nil {
+		t.Fatalf("failed to find generator tests: %v", err)
+	}
+	if len(files) == 0 {
+		t.Fatal("expected to find generator tests")
+	}
+	for _, file := range files {
+		file := file
+		t.Run(filepath.Base(filepath.Dir(file)), func(t *testing.T) {
+			html, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("failed to read expected HTML: %v", err)
+			}
+			actual := new(bytes.Buffer)
+			if err = templ.RenderText(context.Background(), actual, htmlComponent(string(html))); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			textFile := filepath.Join(filepath.Dir(file), "expected.txt")
+			if *updateText {
+				if err = os.WriteFile(textFile, actual.Bytes(), 0644); err != nil {
+					t.Fatalf("failed to update expected text: %v", err)
+				}
+				return
+			}
+			expected, err := os.ReadFile(textFile)
+			if err != nil {
+				t.Fatalf("failed to read expected text: %v", err)
+			}
+			if diff := cmp.Diff(string(expected), actual.String()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
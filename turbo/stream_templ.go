@@ -9,7 +9,7 @@ import "context"
 import "io"
 import "bytes"
 
-func actionTemplate(action string, target string) templ.Component {
+func /*line stream.templ:3:6*/ actionTemplate(action string, target string) /*line stream_templ.go:12:108*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -17,6 +17,11 @@ func actionTemplate(action string, target string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "actionTemplate", "turbo", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_1 := templ.GetChildren(ctx)
 		if var_1 == nil {
 			var_1 = templ.NopComponent
@@ -26,7 +31,7 @@ func actionTemplate(action string, target string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(action))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line stream.templ:4:24*/ action /*line stream_templ.go:34:120*/))
 		if err != nil {
 			return err
 		}
@@ -34,7 +39,7 @@ func actionTemplate(action string, target string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(target))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line stream.templ:4:42*/ target /*line stream_templ.go:42:120*/))
 		if err != nil {
 			return err
 		}
@@ -44,12 +49,15 @@ func actionTemplate(action string, target string) templ.Component {
 		}
 		err = var_1.Render(ctx, templBuffer)
 		if err != nil {
-			return err
+			return &templ.Error{Err: err, Name: "actionTemplate", FileName: "stream.templ"}
 		}
 		_, err = templBuffer.WriteString("</template></turbo-stream>")
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}
@@ -57,7 +65,7 @@ func actionTemplate(action string, target string) templ.Component {
 	})
 }
 
-func removeTemplate(action string, target string) templ.Component {
+func /*line stream.templ:11:6*/ removeTemplate(action string, target string) /*line stream_templ.go:68:109*/ templ.Component {
 	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) (err error) {
 		templBuffer, templIsBuffer := w.(*bytes.Buffer)
 		if !templIsBuffer {
@@ -65,6 +73,11 @@ func removeTemplate(action string, target string) templ.Component {
 			defer templ.ReleaseBuffer(templBuffer)
 		}
 		ctx = templ.InitializeContext(ctx)
+		var templRender *templ.ObservedRender
+		if templObserver := templ.GetObserver(ctx); templObserver != nil {
+			ctx, templRender = templ.StartRender(ctx, templObserver, "removeTemplate", "turbo", templBuffer)
+			defer func() { templRender.End(err) }()
+		}
 		var_2 := templ.GetChildren(ctx)
 		if var_2 == nil {
 			var_2 = templ.NopComponent
@@ -74,7 +87,7 @@ func removeTemplate(action string, target string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(action))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line stream.templ:12:24*/ action /*line stream_templ.go:90:121*/))
 		if err != nil {
 			return err
 		}
@@ -82,7 +95,7 @@ func removeTemplate(action string, target string) templ.Component {
 		if err != nil {
 			return err
 		}
-		_, err = templBuffer.WriteString(templ.EscapeString(target))
+		_, err = templBuffer.WriteString(templ.EscapeString( /*line stream.templ:12:42*/ target /*line stream_templ.go:98:121*/))
 		if err != nil {
 			return err
 		}
@@ -90,6 +103,9 @@ func removeTemplate(action string, target string) templ.Component {
 		if err != nil {
 			return err
 		}
+		if templRender != nil {
+			templRender.Rendered()
+		}
 		if !templIsBuffer {
 			_, err = templBuffer.WriteTo(w)
 		}